@@ -0,0 +1,10 @@
+data "looker_color_collection" "brand" {
+  label = "Brand Colors"
+}
+
+data "looker_color_collection" "default" {}
+
+resource "looker_theme" "brand" {
+  name                = "brand"
+  color_collection_id = data.looker_color_collection.brand.id
+}
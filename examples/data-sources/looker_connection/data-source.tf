@@ -0,0 +1,9 @@
+data "looker_connection" "warehouse" {
+  name = "bigquery_connection"
+}
+
+resource "looker_lookml_model" "lookml_model" {
+  name                        = "LookML Model"
+  allowed_db_connection_names = [data.looker_connection.warehouse.name]
+  project_name                = "lookml_model"
+}
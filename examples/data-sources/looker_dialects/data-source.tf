@@ -0,0 +1,16 @@
+data "looker_dialects" "all" {}
+
+locals {
+  snowflake = one([for d in data.looker_dialects.all.dialects : d if d.name == "snowflake"])
+}
+
+resource "looker_connection" "snowflake_connection" {
+  name         = "snowflake_connection"
+  host         = var.snowflake_host
+  port         = local.snowflake.default_port
+  username     = var.snowflake_username
+  password     = var.snowflake_password
+  database     = "DATABASE"
+  ssl          = contains(local.snowflake.supported_options, "ssl")
+  dialect_name = local.snowflake.name
+}
@@ -0,0 +1,7 @@
+data "looker_folder" "monthly_finance" {
+  path = "Shared/Finance/Monthly"
+}
+
+data "looker_folder" "shared" {
+  folder_id = "1"
+}
@@ -0,0 +1,15 @@
+data "looker_inline_query" "inactive_users" {
+  model  = "system__activity"
+  view   = "user"
+  fields = ["user.id", "user.email"]
+  filters = {
+    "user_facts.last_ui_login_date" = "before 90 days ago"
+    "user.is_disabled"              = "No"
+  }
+  sorts = ["user.id"]
+  limit = 5000
+}
+
+output "inactive_user_emails" {
+  value = [for row in data.looker_inline_query.inactive_users.rows : row["user.email"]]
+}
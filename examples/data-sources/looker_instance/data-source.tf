@@ -0,0 +1,12 @@
+data "looker_instance" "current" {}
+
+resource "looker_group" "finance" {
+  name = "Finance"
+
+  lifecycle {
+    precondition {
+      condition     = data.looker_instance.current.web_server_url == "https://prod.looker.example.com"
+      error_message = "This module must only be applied to the production Looker instance."
+    }
+  }
+}
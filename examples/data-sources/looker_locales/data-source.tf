@@ -0,0 +1,5 @@
+data "looker_locales" "all" {}
+
+output "locale_codes" {
+  value = data.looker_locales.all.codes
+}
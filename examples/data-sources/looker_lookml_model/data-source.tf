@@ -0,0 +1,15 @@
+data "looker_lookml_model" "ecommerce" {
+  name = "ecommerce"
+}
+
+resource "looker_model_set" "model_set" {
+  name   = "ecommerce_models"
+  models = [data.looker_lookml_model.ecommerce.name]
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.looker_lookml_model.ecommerce.explores[*].name, "order_items")
+      error_message = "The ecommerce model must expose the order_items explore."
+    }
+  }
+}
@@ -0,0 +1,15 @@
+data "looker_lookml_model_explore" "users" {
+  model_name   = "ecommerce"
+  explore_name = "users"
+}
+
+locals {
+  unprotected_pii = [
+    for f in data.looker_lookml_model_explore.users.dimensions : f.name
+    if contains(f.tags, "pii") && length(f.required_access_grants) == 0
+  ]
+}
+
+output "unprotected_pii_fields" {
+  value = local.unprotected_pii
+}
@@ -0,0 +1,16 @@
+data "looker_project" "ecommerce" {
+  project_id = "ecommerce"
+}
+
+resource "looker_lookml_model" "ecommerce" {
+  name                        = "ecommerce"
+  allowed_db_connection_names = ["bigquery_connection"]
+  project_name                = data.looker_project.ecommerce.project_id
+
+  lifecycle {
+    precondition {
+      condition     = !data.looker_project.ecommerce.has_uncommitted_changes && data.looker_project.ecommerce.current_ref == data.looker_project.ecommerce.production_ref
+      error_message = "The ecommerce project must be committed and deployed to production."
+    }
+  }
+}
@@ -0,0 +1,10 @@
+data "looker_project_git_deploy_key" "ecommerce" {
+  project_id = "ecommerce"
+}
+
+resource "github_repository_deploy_key" "looker" {
+  title      = "Looker"
+  repository = "lookml-ecommerce"
+  key        = data.looker_project_git_deploy_key.ecommerce.public_key
+  read_only  = false
+}
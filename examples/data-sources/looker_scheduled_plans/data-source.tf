@@ -0,0 +1,9 @@
+# Schedules that would break when the user is deactivated
+data "looker_scheduled_plans" "owned_by_user" {
+  user_id = "42"
+}
+
+# Schedules delivering a dashboard
+data "looker_scheduled_plans" "sales_dashboard" {
+  dashboard_id = "7"
+}
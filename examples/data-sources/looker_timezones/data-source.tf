@@ -0,0 +1,5 @@
+data "looker_timezones" "all" {}
+
+output "common_timezones" {
+  value = [for timezone in data.looker_timezones.all.timezones : timezone.value if timezone.group == "Common"]
+}
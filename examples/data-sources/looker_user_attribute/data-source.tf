@@ -0,0 +1,9 @@
+data "looker_user_attribute" "locale" {
+  name = "locale"
+}
+
+resource "looker_user_attribute_group_value" "japanese_locale" {
+  group_id          = looker_group.japan.id
+  user_attribute_id = data.looker_user_attribute.locale.id
+  value             = "ja_JP"
+}
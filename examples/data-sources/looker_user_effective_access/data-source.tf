@@ -0,0 +1,10 @@
+data "looker_user_effective_access" "analyst" {
+  user_id = "42"
+}
+
+output "analyst_permissions" {
+  value = {
+    for model_set in data.looker_user_effective_access.analyst.model_sets :
+    model_set.model_set_name => model_set.permissions
+  }
+}
@@ -0,0 +1,2 @@
+# import by alert ID
+terraform import looker_alert.failed_orders 42
@@ -0,0 +1,14 @@
+resource "looker_alert" "failed_orders" {
+  dashboard_element_id = looker_dashboard.orders.element.0.id
+  field_name           = "orders.failed_count"
+  title                = "Failed orders"
+  comparison_type      = "GREATER_THAN"
+  threshold            = 10
+  cron                 = "*/30 * * * *"
+
+  destination {
+    type                        = "ACTION_HUB"
+    action_hub_integration_id   = "1::slack_app"
+    action_hub_form_params_json = jsonencode({ channel = "#data-quality" })
+  }
+}
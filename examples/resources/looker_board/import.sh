@@ -0,0 +1,2 @@
+# import by board ID
+terraform import looker_board.finance 42
@@ -0,0 +1,26 @@
+resource "looker_board" "finance" {
+  title       = "Finance"
+  description = "Curated content of the finance team"
+
+  section {
+    title = "Revenue"
+
+    item {
+      dashboard_id = looker_dashboard.revenue.id
+    }
+
+    item {
+      look_id = looker_look.signups_by_state.id
+      title   = "Signups"
+    }
+  }
+
+  section {
+    title = "Resources"
+
+    item {
+      url   = "https://wiki.example.com/finance/metrics"
+      title = "Metric definitions"
+    }
+  }
+}
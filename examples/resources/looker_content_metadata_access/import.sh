@@ -0,0 +1,2 @@
+# import by the content metadata ID of the folder
+terraform import looker_content_metadata_access.finance 128
@@ -0,0 +1,19 @@
+resource "looker_content_metadata_access" "finance" {
+  content_metadata_id = looker_folder.finance.content_metadata_id
+  inherits            = false
+
+  group_access {
+    group_id        = looker_group.finance_analysts.id
+    permission_type = "edit"
+  }
+
+  group_access {
+    group_id        = looker_group.all_employees.id
+    permission_type = "view"
+  }
+
+  user_access {
+    user_id         = "42"
+    permission_type = "edit"
+  }
+}
@@ -0,0 +1,2 @@
+# import by dashboard ID
+terraform import looker_dashboard.signups 42
@@ -0,0 +1,48 @@
+data "looker_folder" "shared" {
+  path = "Shared"
+}
+
+resource "looker_dashboard" "signups" {
+  title            = "Signups"
+  folder_id        = data.looker_folder.shared.id
+  refresh_interval = "1 hour"
+
+  filter {
+    name      = "Created"
+    title     = "Created Date"
+    type      = "field_filter"
+    model     = "thelook"
+    explore   = "users"
+    dimension = "users.created_date"
+  }
+
+  element {
+    type       = "text"
+    title_text = "Signups"
+    body_text  = "Signups per state"
+    row        = 0
+    column     = 0
+    width      = 24
+    height     = 2
+  }
+
+  element {
+    type  = "vis"
+    title = "Signups per state"
+    query {
+      model      = "thelook"
+      view       = "users"
+      fields     = ["users.state", "users.count"]
+      sorts      = ["users.count desc"]
+      limit      = 500
+      vis_config = jsonencode({ type = "looker_column" })
+    }
+    listen = {
+      Created = "users.created_date"
+    }
+    row    = 2
+    column = 0
+    width  = 12
+    height = 6
+  }
+}
@@ -0,0 +1,5 @@
+# import by folder ID
+terraform import looker_folder.finance 42
+
+# import by folder path
+terraform import looker_folder.finance_monthly Shared/Finance/Monthly
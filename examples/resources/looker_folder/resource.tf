@@ -0,0 +1,13 @@
+data "looker_folder" "shared" {
+  path = "Shared"
+}
+
+resource "looker_folder" "finance" {
+  name      = "Finance"
+  parent_id = data.looker_folder.shared.id
+}
+
+resource "looker_folder" "finance_monthly" {
+  name      = "Monthly"
+  parent_id = looker_folder.finance.id
+}
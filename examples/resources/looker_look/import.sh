@@ -0,0 +1,2 @@
+# import by look ID
+terraform import looker_look.signups_by_state 42
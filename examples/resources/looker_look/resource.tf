@@ -0,0 +1,20 @@
+data "looker_folder" "shared" {
+  path = "Shared"
+}
+
+resource "looker_look" "signups_by_state" {
+  title     = "Signups by state"
+  folder_id = data.looker_folder.shared.id
+
+  query {
+    model  = "thelook"
+    view   = "users"
+    fields = ["users.state", "users.count"]
+    filters = {
+      "users.country" = "USA"
+    }
+    sorts      = ["users.count desc"]
+    limit      = 500
+    vis_config = jsonencode({ type = "looker_bar" })
+  }
+}
@@ -0,0 +1,2 @@
+# import by the ID of the user-defined dashboard linked to the LookML dashboard
+terraform import looker_lookml_dashboard_sync.business_pulse 42
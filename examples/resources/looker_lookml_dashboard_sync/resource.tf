@@ -0,0 +1,12 @@
+data "looker_project" "ecommerce" {
+  project_id = "ecommerce"
+}
+
+resource "looker_lookml_dashboard_sync" "business_pulse" {
+  lookml_dashboard_id = "ecommerce::business_pulse"
+  folder_id           = looker_folder.sales.id
+  title               = "Business Pulse"
+
+  # sync the copy whenever a new commit is deployed to production
+  project_commit = data.looker_project.ecommerce.production_ref
+}
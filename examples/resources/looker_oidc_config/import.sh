@@ -0,0 +1,2 @@
+# the OpenID Connect configuration is a singleton and always has the ID oidc, the client secret cannot be imported
+terraform import looker_oidc_config.main oidc
@@ -0,0 +1,29 @@
+resource "looker_oidc_config" "main" {
+  enabled                = true
+  issuer                 = "https://accounts.example.com"
+  client_id              = "looker"
+  client_secret          = var.oidc_client_secret
+  authorization_endpoint = "https://accounts.example.com/oauth2/authorize"
+  token_endpoint         = "https://accounts.example.com/oauth2/token"
+  userinfo_endpoint      = "https://accounts.example.com/oauth2/userinfo"
+  scopes                 = ["openid", "email", "profile", "groups"]
+
+  user_attribute_map_email      = "email"
+  user_attribute_map_first_name = "given_name"
+  user_attribute_map_last_name  = "family_name"
+
+  default_new_user_role_ids = [looker_role.viewer.id]
+
+  set_roles_from_groups = true
+  groups_attribute      = "groups"
+
+  group_mapping {
+    name     = "looker-admins"
+    role_ids = [looker_role.admin.id]
+  }
+
+  user_attribute_mapping {
+    name               = "department"
+    user_attribute_ids = [looker_user_attribute.department.id]
+  }
+}
@@ -0,0 +1,2 @@
+# the SAML configuration is a singleton and always has the ID saml
+terraform import looker_saml_config.main saml
@@ -0,0 +1,32 @@
+resource "looker_saml_config" "main" {
+  enabled    = true
+  idp_url    = "https://idp.example.com/app/looker/sso/saml"
+  idp_issuer = "http://www.okta.com/exk1a2b3c4d5e6f7g8h9"
+  idp_cert   = file("${path.module}/idp.crt")
+
+  user_attribute_map_email      = "Email"
+  user_attribute_map_first_name = "FirstName"
+  user_attribute_map_last_name  = "LastName"
+
+  alternate_email_login_allowed = true
+  new_user_migration_types      = ["email"]
+
+  default_new_user_role_ids  = [looker_role.viewer.id]
+  default_new_user_group_ids = [looker_group.everyone.id]
+
+  set_roles_from_groups = true
+  groups_finder_type    = "grouped_attribute_values"
+  groups_attribute      = "Groups"
+
+  group_mapping {
+    name     = "looker-admins"
+    role_ids = [looker_role.admin.id]
+  }
+
+  group_mapping {
+    name     = "looker-developers"
+    role_ids = [looker_role.developer.id, looker_role.viewer.id]
+  }
+
+  test_before_save = true
+}
@@ -0,0 +1,2 @@
+# import by scheduled plan ID, secret parameters of destinations are not imported
+terraform import looker_scheduled_plan.weekly_revenue 42
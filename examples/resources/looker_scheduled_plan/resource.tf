@@ -0,0 +1,29 @@
+resource "looker_scheduled_plan" "weekly_revenue" {
+  name         = "Weekly revenue"
+  dashboard_id = "42"
+  crontab      = "0 6 * * 1"
+  timezone     = "America/New_York"
+  filters = {
+    "Order Date" = "last week"
+  }
+
+  destination {
+    type      = "email"
+    address   = "finance@example.com"
+    format    = "wysiwyg_pdf"
+    apply_vis = true
+  }
+
+  destination {
+    type    = "s3"
+    address = "s3://finance-reports/weekly/"
+    format  = "csv_zip"
+    parameters = {
+      access_key_id = "AKIAEXAMPLE"
+      region        = "us-east-1"
+    }
+    secret_parameters = {
+      secret_access_key = var.finance_reports_secret_access_key
+    }
+  }
+}
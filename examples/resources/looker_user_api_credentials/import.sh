@@ -0,0 +1,2 @@
+# import by <user_id>:<credentials_id>, the client secret cannot be imported
+terraform import looker_user_api_credentials.etl 42:7
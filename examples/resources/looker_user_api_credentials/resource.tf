@@ -0,0 +1,20 @@
+resource "looker_user" "etl" {
+  first_name = "ETL"
+  last_name  = "Service Account"
+  email      = "etl@example.com"
+}
+
+resource "time_rotating" "etl" {
+  rotation_days = 90
+}
+
+resource "looker_user_api_credentials" "etl" {
+  user_id = looker_user.etl.id
+  triggers = {
+    rotation = time_rotating.etl.id
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
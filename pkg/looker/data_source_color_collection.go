@@ -0,0 +1,203 @@
+package looker
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceColorCollection() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceColorCollectionRead,
+		Description: "Finds a color collection by label. Without a label the default color collection of the instance is returned.",
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"categorical_palettes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"colors": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"sequential_palettes": continuousPaletteListSchema(),
+			"diverging_palettes":  continuousPaletteListSchema(),
+		},
+	}
+}
+
+func continuousPaletteListSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"label": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"stops": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"color": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"offset": {
+								Type:        schema.TypeInt,
+								Description: "Offset in the palette from 0 to 100",
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceColorCollectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	defaultCollection, err := client.DefaultColorCollection(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	colorCollection := defaultCollection
+	if v, ok := d.GetOk("label"); ok {
+		label := v.(string)
+
+		colorCollections, err := client.AllColorCollections("", nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var found []apiclient.ColorCollection
+		for _, c := range colorCollections {
+			if stringValue(c.Label) == label {
+				found = append(found, c)
+			}
+		}
+		switch len(found) {
+		case 0:
+			return diag.Errorf("color collection %q not found", label)
+		case 1:
+			colorCollection = found[0]
+		default:
+			return diag.Errorf("%d color collections are labeled %q", len(found), label)
+		}
+	}
+
+	d.SetId(stringValue(colorCollection.Id))
+
+	if err = d.Set("label", colorCollection.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_default", stringValue(colorCollection.Id) == stringValue(defaultCollection.Id)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("categorical_palettes", flattenDiscretePalettes(colorCollection.CategoricalPalettes)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("sequential_palettes", flattenContinuousPalettes(colorCollection.SequentialPalettes)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("diverging_palettes", flattenContinuousPalettes(colorCollection.DivergingPalettes)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenDiscretePalettes(palettes *[]apiclient.DiscretePalette) []map[string]interface{} {
+	if palettes == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(*palettes))
+	for _, palette := range *palettes {
+		colors := []string{}
+		if palette.Colors != nil {
+			colors = *palette.Colors
+		}
+		result = append(result, map[string]interface{}{
+			"id":     stringValue(palette.Id),
+			"label":  stringValue(palette.Label),
+			"type":   stringValue(palette.Type),
+			"colors": colors,
+		})
+	}
+
+	return result
+}
+
+func flattenContinuousPalettes(palettes *[]apiclient.ContinuousPalette) []map[string]interface{} {
+	if palettes == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(*palettes))
+	for _, palette := range *palettes {
+		stops := []map[string]interface{}{}
+		if palette.Stops != nil {
+			for _, stop := range *palette.Stops {
+				var offset int64
+				if stop.Offset != nil {
+					offset = *stop.Offset
+				}
+				stops = append(stops, map[string]interface{}{
+					"color":  stringValue(stop.Color),
+					"offset": int(offset),
+				})
+			}
+		}
+		result = append(result, map[string]interface{}{
+			"id":    stringValue(palette.Id),
+			"label": stringValue(palette.Label),
+			"type":  stringValue(palette.Type),
+			"stops": stops,
+		})
+	}
+
+	return result
+}
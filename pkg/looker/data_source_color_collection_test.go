@@ -0,0 +1,73 @@
+package looker
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_DataSourceColorCollection(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "looker_color_collection" "default" {}
+				data "looker_color_collection" "by_label" {
+					label = data.looker_color_collection.default.label
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_color_collection.default", "is_default", "true"),
+					resource.TestCheckResourceAttrSet("data.looker_color_collection.default", "categorical_palettes.0.colors.0"),
+					resource.TestCheckResourceAttrPair("data.looker_color_collection.by_label", "id", "data.looker_color_collection.default", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenContinuousPalettes(t *testing.T) {
+	color := "#FFFFFF"
+	offset := int64(100)
+	id := "sequential-0"
+
+	tests := map[string]struct {
+		palettes *[]apiclient.ContinuousPalette
+		wantRes  []map[string]interface{}
+	}{
+		"nil": {
+			palettes: nil,
+			wantRes:  nil,
+		},
+		"palette with stops": {
+			palettes: &[]apiclient.ContinuousPalette{
+				{
+					Id:    &id,
+					Stops: &[]apiclient.ColorStop{{Color: &color, Offset: &offset}, {Color: &color}},
+				},
+			},
+			wantRes: []map[string]interface{}{
+				{
+					"id":    "sequential-0",
+					"label": "",
+					"type":  "",
+					"stops": []map[string]interface{}{
+						{"color": "#FFFFFF", "offset": 100},
+						{"color": "#FFFFFF", "offset": 0},
+					},
+				},
+			},
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			a.Equal(tt.wantRes, flattenContinuousPalettes(tt.palettes))
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package looker
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceConnection() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConnectionRead,
+		Description: "Reads an existing database connection. Secret fields (password, certificate) are never exposed.",
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_timezone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_timezone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_connections": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_billing_gigabytes": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ssl": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"verify_ssl": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tmp_db_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"jdbc_additional_params": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pool_timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"dialect_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_attribute_fields": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"maintenance_cron": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sql_runner_precache_tables": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"sql_writing_with_info_schema": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"after_connect_statements": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tunnel_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pdt_concurrency": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"disable_context_comment": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"oauth_application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dialect": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Capabilities of the connection's SQL dialect",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supports_cost_estimate": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_streaming": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_inducer": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_multiple_databases": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_persistent_derived_tables": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"has_ssl_support": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"connection_tests": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceConnectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	connectionName := d.Get("name").(string)
+
+	connection, err := client.Connection(connectionName, "", nil)
+	if err != nil {
+		return diag.Errorf("failed to read connection %q: %v", connectionName, err)
+	}
+
+	d.SetId(*connection.Name)
+
+	return diag.FromErr(flattenDataSourceConnection(connection, d))
+}
+
+func flattenDataSourceConnection(connection apiclient.DBConnection, d *schema.ResourceData) error {
+	if err := d.Set("name", connection.Name); err != nil {
+		return err
+	}
+	if err := d.Set("host", connection.Host); err != nil {
+		return err
+	}
+	if err := d.Set("port", connection.Port); err != nil {
+		return err
+	}
+	if err := d.Set("username", connection.Username); err != nil {
+		return err
+	}
+	if err := d.Set("database", connection.Database); err != nil {
+		return err
+	}
+	if err := d.Set("db_timezone", connection.DbTimezone); err != nil {
+		return err
+	}
+	if err := d.Set("query_timezone", connection.QueryTimezone); err != nil {
+		return err
+	}
+	if err := d.Set("schema", connection.Schema); err != nil {
+		return err
+	}
+	if err := d.Set("max_connections", connection.MaxConnections); err != nil {
+		return err
+	}
+	if err := d.Set("max_billing_gigabytes", connection.MaxBillingGigabytes); err != nil {
+		return err
+	}
+	if err := d.Set("ssl", connection.Ssl); err != nil {
+		return err
+	}
+	if err := d.Set("verify_ssl", connection.VerifySsl); err != nil {
+		return err
+	}
+	if err := d.Set("tmp_db_name", connection.TmpDbName); err != nil {
+		return err
+	}
+	if err := d.Set("jdbc_additional_params", connection.JdbcAdditionalParams); err != nil {
+		return err
+	}
+	if err := d.Set("pool_timeout", connection.PoolTimeout); err != nil {
+		return err
+	}
+	if err := d.Set("dialect_name", connection.DialectName); err != nil {
+		return err
+	}
+	if connection.UserAttributeFields != nil {
+		if err := d.Set("user_attribute_fields", flattenStringListToSet(*connection.UserAttributeFields)); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("maintenance_cron", connection.MaintenanceCron); err != nil {
+		return err
+	}
+	if err := d.Set("sql_runner_precache_tables", connection.SqlRunnerPrecacheTables); err != nil {
+		return err
+	}
+	if err := d.Set("sql_writing_with_info_schema", connection.SqlWritingWithInfoSchema); err != nil {
+		return err
+	}
+	if err := d.Set("after_connect_statements", connection.AfterConnectStatements); err != nil {
+		return err
+	}
+	if err := d.Set("tunnel_id", connection.TunnelId); err != nil {
+		return err
+	}
+	if err := d.Set("pdt_concurrency", connection.PdtConcurrency); err != nil {
+		return err
+	}
+	if err := d.Set("disable_context_comment", connection.DisableContextComment); err != nil {
+		return err
+	}
+	if err := d.Set("oauth_application_id", connection.OauthApplicationId); err != nil {
+		return err
+	}
+	if err := d.Set("dialect", flattenDialect(connection.Dialect)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func flattenDialect(dialect *apiclient.Dialect) []map[string]interface{} {
+	if dialect == nil {
+		return nil
+	}
+
+	result := make(map[string]interface{})
+	if dialect.Name != nil {
+		result["name"] = *dialect.Name
+	}
+	if dialect.Label != nil {
+		result["label"] = *dialect.Label
+	}
+	if dialect.SupportsCostEstimate != nil {
+		result["supports_cost_estimate"] = *dialect.SupportsCostEstimate
+	}
+	if dialect.SupportsStreaming != nil {
+		result["supports_streaming"] = *dialect.SupportsStreaming
+	}
+	if dialect.SupportsInducer != nil {
+		result["supports_inducer"] = *dialect.SupportsInducer
+	}
+	if dialect.SupportsMultipleDatabases != nil {
+		result["supports_multiple_databases"] = *dialect.SupportsMultipleDatabases
+	}
+	if dialect.SupportsPersistentDerivedTables != nil {
+		result["supports_persistent_derived_tables"] = *dialect.SupportsPersistentDerivedTables
+	}
+	if dialect.HasSslSupport != nil {
+		result["has_ssl_support"] = *dialect.HasSslSupport
+	}
+	if dialect.ConnectionTests != nil {
+		result["connection_tests"] = *dialect.ConnectionTests
+	}
+
+	return []map[string]interface{}{result}
+}
@@ -0,0 +1,89 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_DataSourceConnection(t *testing.T) {
+	name := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dataSourceConnectionConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_connection.test", "name", name),
+					resource.TestCheckResourceAttr("data.looker_connection.test", "host", "test_project"),
+					resource.TestCheckResourceAttr("data.looker_connection.test", "dialect_name", "bigquery_standard_sql"),
+					resource.TestCheckResourceAttr("data.looker_connection.test", "dialect.0.name", "bigquery_standard_sql"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenDialect(t *testing.T) {
+	name := "bigquery_standard_sql"
+	label := "Google BigQuery Standard SQL"
+	supportsPDT := true
+	tests := []string{"connect", "query"}
+
+	tc := map[string]struct {
+		dialect *apiclient.Dialect
+		wantRes []map[string]interface{}
+	}{
+		"nil dialect": {
+			dialect: nil,
+			wantRes: nil,
+		},
+		"partial dialect": {
+			dialect: &apiclient.Dialect{
+				Name:                            &name,
+				Label:                           &label,
+				SupportsPersistentDerivedTables: &supportsPDT,
+				ConnectionTests:                 &tests,
+			},
+			wantRes: []map[string]interface{}{
+				{
+					"name":                               name,
+					"label":                              label,
+					"supports_persistent_derived_tables": true,
+					"connection_tests":                   tests,
+				},
+			},
+		},
+	}
+
+	for key, tt := range tc {
+		t.Run(key, func(t *testing.T) {
+			assert.Equal(t, tt.wantRes, flattenDialect(tt.dialect))
+		})
+	}
+}
+
+func dataSourceConnectionConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "looker_connection" "test" {
+		name         = "%s"
+		host         = "test_project"
+		username     = "test@testproject.iam.gserviceaccount.com"
+		certificate  = filebase64("testdata/gcp-sa.json")
+		file_type    = ".json"
+		database     = "test_dataset"
+		tmp_db_name  = "tmp_test_dataset"
+		dialect_name = "bigquery_standard_sql"
+	}
+	data "looker_connection" "test" {
+		name = looker_connection.test.name
+	}
+	`, name)
+}
@@ -0,0 +1,184 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceDialects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDialectsRead,
+		Description: "Lists the SQL dialects supported by the Looker instance. " +
+			"The Looker API only reports persistent derived table support for dialects used by at least one connection, " +
+			"and does not report whether a dialect supports SSH tunnels.",
+		Schema: map[string]*schema.Schema{
+			"dialects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Dialect name, as used in `looker_connection.dialect_name`",
+							Computed:    true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_max_connections": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"installed": {
+							Type:        schema.TypeBool,
+							Description: "Whether the supporting driver is installed",
+							Computed:    true,
+						},
+						"label_for_database_equivalent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supports_tmp_db": {
+							Type:        schema.TypeBool,
+							Description: "Whether the dialect accepts a temporary database (`tmp_db_name`)",
+							Computed:    true,
+						},
+						"supports_pdt": {
+							Type:        schema.TypeBool,
+							Description: "Whether the dialect supports persistent derived tables. Only meaningful when `pdt_support_known` is true",
+							Computed:    true,
+						},
+						"pdt_support_known": {
+							Type:        schema.TypeBool,
+							Description: "Whether `supports_pdt` is known. Looker only reports it for dialects used by at least one connection",
+							Computed:    true,
+						},
+						"supported_options": {
+							Type:        schema.TypeSet,
+							Description: "Connection options accepted by the dialect, e.g. `host`, `schema`, `ssl`, `timezone`",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDialectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	dialectInfos, err := client.AllDialectInfos("", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+
+	// PDT support is only exposed on the dialect of a connection, not by /dialect_info
+	pdtSupport := map[string]bool{}
+	connections, err := client.AllConnections("name,dialect", nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unable to read persistent derived table support",
+			Detail:   fmt.Sprintf("Listing connections failed, supports_pdt is unknown for every dialect: %s", err),
+		})
+	}
+	for _, connection := range connections {
+		if connection.Dialect == nil || connection.Dialect.SupportsPersistentDerivedTables == nil {
+			continue
+		}
+		pdtSupport[stringValue(connection.Dialect.Name)] = *connection.Dialect.SupportsPersistentDerivedTables
+	}
+
+	sort.Slice(dialectInfos, func(i, j int) bool {
+		return stringValue(dialectInfos[i].Name) < stringValue(dialectInfos[j].Name)
+	})
+
+	var dialects []map[string]interface{}
+	var names []string
+	for _, dialectInfo := range dialectInfos {
+		dialects = append(dialects, flattenDialectInfo(dialectInfo, pdtSupport))
+		names = append(names, stringValue(dialectInfo.Name))
+	}
+
+	d.SetId(session.Config.BaseUrl)
+
+	if err = d.Set("dialects", dialects); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func flattenDialectInfo(dialectInfo apiclient.DialectInfo, pdtSupport map[string]bool) map[string]interface{} {
+	result := map[string]interface{}{
+		"name":                          stringValue(dialectInfo.Name),
+		"label":                         stringValue(dialectInfo.Label),
+		"default_port":                  stringValue(dialectInfo.DefaultPort),
+		"default_max_connections":       stringValue(dialectInfo.DefaultMaxConnections),
+		"installed":                     boolValue(dialectInfo.Installed),
+		"label_for_database_equivalent": stringValue(dialectInfo.LabelForDatabaseEquivalent),
+		"supported_options":             flattenDialectInfoOptions(dialectInfo.SupportedOptions),
+	}
+
+	supportsPDT, ok := pdtSupport[stringValue(dialectInfo.Name)]
+	result["supports_pdt"] = supportsPDT
+	result["pdt_support_known"] = ok
+
+	if dialectInfo.SupportedOptions != nil {
+		result["supports_tmp_db"] = boolValue(dialectInfo.SupportedOptions.TmpTable)
+	}
+
+	return result
+}
+
+// flattenDialectInfoOptions returns the names of the options the dialect supports
+func flattenDialectInfoOptions(options *apiclient.DialectInfoOptions) []string {
+	supported := []string{}
+	if options == nil {
+		return supported
+	}
+
+	for name, v := range map[string]*bool{
+		"additional_params": options.AdditionalParams,
+		"auth":              options.Auth,
+		"host":              options.Host,
+		"oauth_credentials": options.OauthCredentials,
+		"project_name":      options.ProjectName,
+		"schema":            options.Schema,
+		"ssl":               options.Ssl,
+		"timezone":          options.Timezone,
+		"tmp_table":         options.TmpTable,
+		"username_required": options.UsernameRequired,
+	} {
+		if boolValue(v) {
+			supported = append(supported, name)
+		}
+	}
+	sort.Strings(supported)
+
+	return supported
+}
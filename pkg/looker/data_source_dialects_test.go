@@ -0,0 +1,92 @@
+package looker
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_DataSourceDialects(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "looker_dialects" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.looker_dialects.test", "dialects.#"),
+					resource.TestCheckResourceAttrSet("data.looker_dialects.test", "names.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenDialectInfoOptions(t *testing.T) {
+	yes := true
+	no := false
+
+	tests := map[string]struct {
+		options *apiclient.DialectInfoOptions
+		wantRes []string
+	}{
+		"nil options": {
+			options: nil,
+			wantRes: []string{},
+		},
+		"no supported options": {
+			options: &apiclient.DialectInfoOptions{Host: &no},
+			wantRes: []string{},
+		},
+		"sorted supported options": {
+			options: &apiclient.DialectInfoOptions{
+				Timezone: &yes,
+				Host:     &yes,
+				Ssl:      &no,
+				TmpTable: &yes,
+			},
+			wantRes: []string{"host", "timezone", "tmp_table"},
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			assert.Equal(t, tt.wantRes, flattenDialectInfoOptions(tt.options))
+		})
+	}
+}
+
+func TestFlattenDialectInfoPDTSupport(t *testing.T) {
+	bigquery := "bigquery_standard_sql"
+	mysql := "mysql"
+	pdtSupport := map[string]bool{bigquery: true}
+
+	tests := map[string]struct {
+		name      string
+		wantPDT   bool
+		wantKnown bool
+	}{
+		"dialect used by a connection": {
+			name:      bigquery,
+			wantPDT:   true,
+			wantKnown: true,
+		},
+		"dialect without connection": {
+			name:      mysql,
+			wantPDT:   false,
+			wantKnown: false,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			name := tt.name
+			res := flattenDialectInfo(apiclient.DialectInfo{Name: &name}, pdtSupport)
+			a.Equal(tt.wantPDT, res["supports_pdt"])
+			a.Equal(tt.wantKnown, res["pdt_support_known"])
+		})
+	}
+}
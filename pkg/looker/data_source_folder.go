@@ -0,0 +1,151 @@
+package looker
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceFolder() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFolderRead,
+		Description: "Reads a folder by ID or by path, e.g. `Shared/Finance/Monthly`.",
+		Schema: map[string]*schema.Schema{
+			"folder_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"folder_id", "path"},
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Description: "Slash separated folder names starting from a root folder, e.g. `Shared/Finance/Monthly`",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_personal": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_personal_descendant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_shared_root": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"content_metadata_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"child_folder_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"child_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"dashboard_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"look_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFolderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	folderID := d.Get("folder_id").(string)
+	if folderID == "" {
+		folder, err := findFolderByPath(client, d.Get("path").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		folderID = *folder.Id
+	}
+
+	folder, err := client.Folder(folderID, "", nil)
+	if err != nil {
+		return diag.Errorf("failed to read folder %q: %v", folderID, err)
+	}
+
+	path, err := buildFolderPath(client, folder)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	children, err := client.FolderChildren(apiclient.RequestFolderChildren{FolderId: folderID}, nil) // todo: implement paging
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	childFolderIDs := make([]string, 0, len(children))
+	for _, child := range children {
+		childFolderIDs = append(childFolderIDs, *child.Id)
+	}
+
+	d.SetId(*folder.Id)
+
+	if err = d.Set("folder_id", folder.Id); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("path", path); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", folder.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parent_id", folder.ParentId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_personal", folder.IsPersonal); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_personal_descendant", folder.IsPersonalDescendant); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_shared_root", folder.IsSharedRoot); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_metadata_id", folder.ContentMetadataId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("child_folder_ids", childFolderIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("child_count", folder.ChildCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if folder.Dashboards != nil {
+		if err = d.Set("dashboard_count", len(*folder.Dashboards)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if folder.Looks != nil {
+		if err = d.Set("look_count", len(*folder.Looks)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package looker
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DataSourceFolder(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dataSourceFolderConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_folder.by_path", "name", "Shared"),
+					resource.TestCheckResourceAttr("data.looker_folder.by_path", "is_shared_root", "true"),
+					resource.TestCheckResourceAttrPair("data.looker_folder.by_id", "path", "data.looker_folder.by_path", "path"),
+				),
+			},
+		},
+	})
+}
+
+func dataSourceFolderConfig() string {
+	return `
+	data "looker_folder" "by_path" {
+		path = "Shared"
+	}
+	data "looker_folder" "by_id" {
+		folder_id = data.looker_folder.by_path.folder_id
+	}
+	`
+}
@@ -0,0 +1,167 @@
+package looker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceInlineQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInlineQueryRead,
+		Description: "Runs a query against a LookML model explore and exposes the result rows.",
+		Schema: map[string]*schema.Schema{
+			"model": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Description: "Name of the explore to query",
+				Required:    true,
+			},
+			"fields": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filters": {
+				Type:        schema.TypeMap,
+				Description: "Looker filter expressions keyed by field name, e.g. `{ \"users.created_date\" = \"before 90 days ago\" }`",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"filter_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sorts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      500,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"query_timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rows": {
+				Type:        schema.TypeList,
+				Description: "Result rows keyed by field name. All values are returned as strings, null values as empty strings.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInlineQueryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	query := expandInlineQuery(d)
+
+	result, err := client.RunInlineQuery(apiclient.RequestRunInlineQuery{
+		ResultFormat: "json",
+		Body:         query,
+	}, nil)
+	if err != nil {
+		return diag.Errorf("failed to run query on %s/%s: %v", query.Model, query.View, err)
+	}
+
+	rows, err := flattenQueryRows(result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildTwoPartID(&query.Model, &query.View))
+
+	if err = d.Set("rows", rows); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandInlineQuery(d *schema.ResourceData) apiclient.WriteQuery {
+	fields := expandStringList(d.Get("fields").([]interface{}))
+	limit := strconv.Itoa(d.Get("limit").(int))
+
+	query := apiclient.WriteQuery{
+		Model:  d.Get("model").(string),
+		View:   d.Get("view").(string),
+		Fields: &fields,
+		Limit:  &limit,
+	}
+
+	if v, ok := d.GetOk("filters"); ok {
+		filters := v.(map[string]interface{})
+		query.Filters = &filters
+	}
+	if v, ok := d.GetOk("filter_expression"); ok {
+		filterExpression := v.(string)
+		query.FilterExpression = &filterExpression
+	}
+	if v, ok := d.GetOk("sorts"); ok {
+		sorts := expandStringList(v.([]interface{}))
+		query.Sorts = &sorts
+	}
+	if v, ok := d.GetOk("query_timezone"); ok {
+		queryTimezone := v.(string)
+		query.QueryTimezone = &queryTimezone
+	}
+
+	return query
+}
+
+// flattenQueryRows converts the `json` result format of a query into rows of string values
+func flattenQueryRows(result string) ([]map[string]string, error) {
+	decoder := json.NewDecoder(bytes.NewBufferString(result))
+	decoder.UseNumber()
+
+	var rawRows []map[string]interface{}
+	if err := decoder.Decode(&rawRows); err != nil {
+		return nil, fmt.Errorf("unexpected query result: %v", err)
+	}
+
+	rows := make([]map[string]string, 0, len(rawRows))
+	for _, rawRow := range rawRows {
+		row := make(map[string]string, len(rawRow))
+		for field, value := range rawRow {
+			switch v := value.(type) {
+			case nil:
+				row[field] = ""
+			case string:
+				row[field] = v
+			case json.Number:
+				row[field] = v.String()
+			default:
+				b, err := json.Marshal(v)
+				if err != nil {
+					return nil, err
+				}
+				row[field] = string(b)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
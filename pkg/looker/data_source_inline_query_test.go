@@ -0,0 +1,51 @@
+package looker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenQueryRows(t *testing.T) {
+	tests := map[string]struct {
+		result  string
+		wantRes []map[string]string
+		wantErr bool
+	}{
+		"mixed value types": {
+			result: `[{"users.email":"a@example.com","users.count":12,"users.is_active":true,"users.last_login":null},{"users.email":"b@example.com","users.count":1.5,"users.is_active":false,"users.last_login":"2022-01-01"}]`,
+			wantRes: []map[string]string{
+				{"users.email": "a@example.com", "users.count": "12", "users.is_active": "true", "users.last_login": ""},
+				{"users.email": "b@example.com", "users.count": "1.5", "users.is_active": "false", "users.last_login": "2022-01-01"},
+			},
+			wantErr: false,
+		},
+		"no rows": {
+			result:  `[]`,
+			wantRes: []map[string]string{},
+			wantErr: false,
+		},
+		"nested pivot values": {
+			result:  `[{"orders.count":{"orders.status":{"complete":3}}}]`,
+			wantRes: []map[string]string{{"orders.count": `{"orders.status":{"complete":3}}`}},
+			wantErr: false,
+		},
+		"error body": {
+			result:  `{"message":"Not found"}`,
+			wantErr: true,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			actual, err := flattenQueryRows(tt.result)
+			if tt.wantErr {
+				a.Error(err)
+			} else {
+				a.NoError(err)
+				a.Equal(tt.wantRes, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package looker
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceInstance() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInstanceRead,
+		Description: "Describes the Looker instance the provider is connected to. License information is not included, as the Looker API exposes no endpoint for it.",
+		Schema: map[string]*schema.Schema{
+			"looker_release_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_api_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"supported_api_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"full_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"api_server_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"web_server_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"extension_framework_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"marketplace_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"marketplace_auto_install_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"onboarding_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"locales": {
+				Type:        schema.TypeList,
+				Description: "Codes of the locales the instance supports",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"timezones": {
+				Type:        schema.TypeList,
+				Description: "Names of the timezones the instance supports",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	versions, err := client.Versions("", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setting, err := client.GetSetting("", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	locales, err := client.AllLocales(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	timezones, err := client.AllTimezones(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(session.Config.BaseUrl)
+
+	if err = d.Set("looker_release_version", versions.LookerReleaseVersion); err != nil {
+		return diag.FromErr(err)
+	}
+	if versions.CurrentVersion != nil {
+		if err = d.Set("current_api_version", versions.CurrentVersion.Version); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = d.Set("supported_api_versions", flattenAPIVersions(versions.SupportedVersions)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("api_server_url", versions.ApiServerUrl); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("web_server_url", versions.WebServerUrl); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("extension_framework_enabled", setting.ExtensionFrameworkEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("marketplace_enabled", setting.MarketplaceEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("marketplace_auto_install_enabled", setting.MarketplaceAutoInstallEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("onboarding_enabled", setting.OnboardingEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+
+	localeCodes := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		localeCodes = append(localeCodes, stringValue(locale.Code))
+	}
+	if err = d.Set("locales", localeCodes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	timezoneNames := make([]string, 0, len(timezones))
+	for _, timezone := range timezones {
+		timezoneNames = append(timezoneNames, stringValue(timezone.Value))
+	}
+	if err = d.Set("timezones", timezoneNames); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenAPIVersions(versions *[]apiclient.ApiVersionElement) []map[string]interface{} {
+	if versions == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(*versions))
+	for _, version := range *versions {
+		result = append(result, map[string]interface{}{
+			"version":      stringValue(version.Version),
+			"full_version": stringValue(version.FullVersion),
+			"status":       stringValue(version.Status),
+		})
+	}
+
+	return result
+}
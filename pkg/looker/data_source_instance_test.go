@@ -0,0 +1,25 @@
+package looker
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DataSourceInstance(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "looker_instance" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.looker_instance.test", "looker_release_version"),
+					resource.TestCheckResourceAttrSet("data.looker_instance.test", "api_server_url"),
+					resource.TestCheckResourceAttrSet("data.looker_instance.test", "supported_api_versions.0.version"),
+					resource.TestCheckResourceAttrSet("data.looker_instance.test", "timezones.0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+package looker
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceLocales() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLocalesRead,
+		Description: "Lists the locales supported by the Looker instance.",
+		Schema: map[string]*schema.Schema{
+			"codes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"locales": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"native_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"english_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLocalesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	locales, err := client.AllLocales(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(session.Config.BaseUrl)
+
+	codes := make([]string, 0, len(locales))
+	result := make([]map[string]interface{}, 0, len(locales))
+	for _, locale := range locales {
+		codes = append(codes, stringValue(locale.Code))
+		result = append(result, map[string]interface{}{
+			"code":         stringValue(locale.Code),
+			"native_name":  stringValue(locale.NativeName),
+			"english_name": stringValue(locale.EnglishName),
+		})
+	}
+
+	if err = d.Set("codes", codes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("locales", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
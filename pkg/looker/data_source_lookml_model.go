@@ -0,0 +1,132 @@
+package looker
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceLookMLModel() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLookMLModelRead,
+		Description: "Reads a LookML model and the explores it exposes.",
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"allowed_db_connection_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"unlimited_db_connections": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"has_content": {
+				Type:        schema.TypeBool,
+				Description: "Whether the model declaration has LookML content",
+				Computed:    true,
+			},
+			"explores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hidden": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"group_label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLookMLModelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	modelName := d.Get("name").(string)
+
+	model, err := client.LookmlModel(modelName, "", nil)
+	if err != nil {
+		return diag.Errorf("failed to read LookML model %q: %v", modelName, err)
+	}
+
+	d.SetId(*model.Name)
+
+	if err = d.Set("name", model.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("project_name", model.ProjectName); err != nil {
+		return diag.FromErr(err)
+	}
+	if model.AllowedDbConnectionNames != nil {
+		if err = d.Set("allowed_db_connection_names", flattenStringListToSet(*model.AllowedDbConnectionNames)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = d.Set("unlimited_db_connections", model.UnlimitedDbConnections); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("label", model.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("has_content", model.HasContent); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("explores", flattenLookMLModelNavExplores(model.Explores)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenLookMLModelNavExplores(explores *[]apiclient.LookmlModelNavExplore) []map[string]interface{} {
+	if explores == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(*explores))
+	for _, explore := range *explores {
+		result = append(result, map[string]interface{}{
+			"name":        stringValue(explore.Name),
+			"label":       stringValue(explore.Label),
+			"description": stringValue(explore.Description),
+			"hidden":      boolValue(explore.Hidden),
+			"group_label": stringValue(explore.GroupLabel),
+		})
+	}
+
+	return result
+}
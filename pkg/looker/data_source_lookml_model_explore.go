@@ -0,0 +1,270 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// The SDK models do not carry required_access_grants, so the explore is decoded
+// into these wrappers which add it on top of the generated types.
+type lookMLModelExplore struct {
+	apiclient.LookmlModelExplore
+	RequiredAccessGrants *[]string                   `json:"required_access_grants,omitempty"`
+	Fields               *lookMLModelExploreFieldset `json:"fields,omitempty"`
+}
+
+type lookMLModelExploreFieldset struct {
+	Dimensions *[]lookMLModelExploreField `json:"dimensions,omitempty"`
+	Measures   *[]lookMLModelExploreField `json:"measures,omitempty"`
+	Filters    *[]lookMLModelExploreField `json:"filters,omitempty"`
+	Parameters *[]lookMLModelExploreField `json:"parameters,omitempty"`
+}
+
+type lookMLModelExploreField struct {
+	apiclient.LookmlModelExploreField
+	RequiredAccessGrants *[]string `json:"required_access_grants,omitempty"`
+}
+
+func dataSourceLookMLModelExplore() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLookMLModelExploreRead,
+		Description: "Reads the field metadata (dimensions, measures, filters and parameters) of a LookML model explore.",
+		Schema: map[string]*schema.Schema{
+			"model_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"explore_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"view_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hidden": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"required_access_grants": {
+				Type:        schema.TypeList,
+				Description: "Access grants required to use the explore. Empty when the instance does not report them.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"access_filters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_attribute": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dimensions": exploreFieldListSchema(),
+			"measures":   exploreFieldListSchema(),
+			"filters":    exploreFieldListSchema(),
+			"parameters": exploreFieldListSchema(),
+		},
+	}
+}
+
+func exploreFieldListSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Fully-qualified name of the field, e.g. `users.email`",
+					Computed:    true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"label": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"label_short": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"view": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"sql": {
+					Type:        schema.TypeString,
+					Description: "SQL expression of the field. Empty if the API user lacks the `see_lookml` permission.",
+					Computed:    true,
+				},
+				"hidden": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"tags": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"required_access_grants": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLookMLModelExploreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+
+	modelName := d.Get("model_name").(string)
+	exploreName := d.Get("explore_name").(string)
+
+	var explore lookMLModelExplore
+	path := fmt.Sprintf("/lookml_models/%v/explores/%v", url.PathEscape(modelName), url.PathEscape(exploreName))
+	if err := session.Do(&explore, "GET", "/4.0", path, nil, nil, nil); err != nil {
+		return diag.Errorf("failed to read explore %q of LookML model %q: %v", exploreName, modelName, err)
+	}
+
+	d.SetId(buildTwoPartID(&modelName, &exploreName))
+
+	if err := d.Set("label", explore.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", explore.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("project_name", explore.ProjectName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("connection_name", explore.ConnectionName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("view_name", explore.ViewName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("hidden", explore.Hidden); err != nil {
+		return diag.FromErr(err)
+	}
+	if explore.Tags != nil {
+		if err := d.Set("tags", *explore.Tags); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if explore.RequiredAccessGrants != nil {
+		if err := d.Set("required_access_grants", *explore.RequiredAccessGrants); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("access_filters", flattenExploreAccessFilters(explore.AccessFilters)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	fields := explore.Fields
+	if fields == nil {
+		fields = &lookMLModelExploreFieldset{}
+	}
+	if err := d.Set("dimensions", flattenExploreFields(fields.Dimensions)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("measures", flattenExploreFields(fields.Measures)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("filters", flattenExploreFields(fields.Filters)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("parameters", flattenExploreFields(fields.Parameters)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenExploreAccessFilters(accessFilters *[]apiclient.LookmlModelExploreAccessFilter) []map[string]interface{} {
+	if accessFilters == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(*accessFilters))
+	for _, accessFilter := range *accessFilters {
+		result = append(result, map[string]interface{}{
+			"field":          stringValue(accessFilter.Field),
+			"user_attribute": stringValue(accessFilter.UserAttribute),
+		})
+	}
+
+	return result
+}
+
+func flattenExploreFields(fields *[]lookMLModelExploreField) []map[string]interface{} {
+	if fields == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(*fields))
+	for _, field := range *fields {
+		f := map[string]interface{}{
+			"name":        stringValue(field.Name),
+			"type":        stringValue(field.Type),
+			"label":       stringValue(field.Label),
+			"label_short": stringValue(field.LabelShort),
+			"description": stringValue(field.Description),
+			"view":        stringValue(field.View),
+			"sql":         stringValue(field.Sql),
+			"hidden":      boolValue(field.Hidden),
+		}
+		if field.Tags != nil {
+			f["tags"] = *field.Tags
+		}
+		if field.RequiredAccessGrants != nil {
+			f["required_access_grants"] = *field.RequiredAccessGrants
+		}
+		result = append(result, f)
+	}
+
+	return result
+}
@@ -0,0 +1,58 @@
+package looker
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookMLModelExploreDecode(t *testing.T) {
+	body := `{
+		"name": "users",
+		"label": "Users",
+		"required_access_grants": ["can_see_pii"],
+		"fields": {
+			"dimensions": [
+				{
+					"name": "users.email",
+					"type": "string",
+					"sql": "${TABLE}.email",
+					"tags": ["pii"],
+					"required_access_grants": ["can_see_pii"]
+				},
+				{
+					"name": "users.id",
+					"type": "number"
+				}
+			],
+			"measures": [
+				{
+					"name": "users.count",
+					"type": "count"
+				}
+			]
+		}
+	}`
+
+	a := assert.New(t)
+
+	var explore lookMLModelExplore
+	a.NoError(json.Unmarshal([]byte(body), &explore))
+	a.Equal("users", *explore.Name)
+	a.Equal([]string{"can_see_pii"}, *explore.RequiredAccessGrants)
+
+	dimensions := flattenExploreFields(explore.Fields.Dimensions)
+	a.Len(dimensions, 2)
+	a.Equal("users.email", dimensions[0]["name"])
+	a.Equal("${TABLE}.email", dimensions[0]["sql"])
+	a.Equal([]string{"pii"}, dimensions[0]["tags"])
+	a.Equal([]string{"can_see_pii"}, dimensions[0]["required_access_grants"])
+	a.NotContains(dimensions[1], "required_access_grants")
+
+	measures := flattenExploreFields(explore.Fields.Measures)
+	a.Len(measures, 1)
+	a.Equal("count", measures[0]["type"])
+
+	a.Nil(flattenExploreFields(explore.Fields.Parameters))
+}
@@ -0,0 +1,40 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DataSourceLookMLModel(t *testing.T) {
+	name := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	connectionName := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dataSourceLookMLModelConfig(name, connectionName, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_lookml_model.test", "name", name),
+					resource.TestCheckResourceAttr("data.looker_lookml_model.test", "project_name", projectName),
+					resource.TestCheckResourceAttr("data.looker_lookml_model.test", "allowed_db_connection_names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func dataSourceLookMLModelConfig(name, connectionName, projectName string) string {
+	return fmt.Sprintf(`
+	%s
+	data "looker_lookml_model" "test" {
+		name = looker_lookml_model.test.name
+	}
+	`, lookMLModelConfig(name, connectionName, projectName))
+}
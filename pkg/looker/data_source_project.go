@@ -0,0 +1,225 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceProject() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProjectRead,
+		Description: "Reads a LookML project's git configuration and the state of its development and production workspaces.",
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uses_git": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"git_service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"git_remote_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"git_production_branch_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"git_release_mgmt_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"pull_request_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"validation_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"allow_warnings": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"current_branch": {
+				Type:        schema.TypeString,
+				Description: "Branch checked out in the API user's development workspace",
+				Computed:    true,
+			},
+			"current_ref": {
+				Type:        schema.TypeString,
+				Description: "Commit the current branch points at",
+				Computed:    true,
+			},
+			"ahead_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of commits the current branch is ahead of its remote",
+				Computed:    true,
+			},
+			"behind_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of commits the current branch is behind its remote",
+				Computed:    true,
+			},
+			"git_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the development workspace's git directory, as reported by Looker",
+				Computed:    true,
+			},
+			"has_uncommitted_changes": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"production_ref": {
+				Type:        schema.TypeString,
+				Description: "Commit currently deployed to production, empty for projects without git",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	projectID := d.Get("project_id").(string)
+
+	if err := selectAPISession(client, DEV_WORKSPACE); err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Project(projectID, "", nil)
+	if err != nil {
+		return diag.Errorf("failed to read project %q: %v", projectID, err)
+	}
+
+	workspace, err := client.ProjectWorkspace(projectID, "", nil)
+	if err != nil {
+		return diag.Errorf("failed to read workspace of project %q: %v", projectID, err)
+	}
+
+	// projects without git have no production branch
+	var productionBranch *apiclient.GitBranch
+	if boolValue(project.UsesGit) {
+		productionBranch, err = readProductionBranch(session, projectID)
+		if err != nil {
+			return diag.Errorf("failed to read production branch of project %q: %v", projectID, err)
+		}
+	}
+
+	d.SetId(*project.Id)
+
+	if err = d.Set("name", project.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("uses_git", project.UsesGit); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("git_service_name", project.GitServiceName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("git_remote_url", project.GitRemoteUrl); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("git_production_branch_name", project.GitProductionBranchName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("git_release_mgmt_enabled", project.GitReleaseMgmtEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if project.PullRequestMode != nil {
+		if err = d.Set("pull_request_mode", string(*project.PullRequestMode)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = d.Set("validation_required", project.ValidationRequired); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_warnings", project.AllowWarnings); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if workspace.GitBranch != nil {
+		if err = d.Set("current_branch", workspace.GitBranch.Name); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("current_ref", workspace.GitBranch.Ref); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("ahead_count", workspace.GitBranch.AheadCount); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("behind_count", workspace.GitBranch.BehindCount); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = d.Set("git_status", workspace.GitStatus); err != nil {
+		return diag.FromErr(err)
+	}
+	hasUncommittedChanges, err := hasUncommittedGitChanges(boolValue(project.UsesGit), stringValue(workspace.GitStatus))
+	if err != nil {
+		return diag.Errorf("project %q: %v", projectID, err)
+	}
+	if err = d.Set("has_uncommitted_changes", hasUncommittedChanges); err != nil {
+		return diag.FromErr(err)
+	}
+	if productionBranch != nil {
+		if err = d.Set("production_ref", productionBranch.Ref); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// readProductionBranch reads the production branch through a separate API session,
+// the workspace of the provider's session is shared with concurrent dev workspace operations
+func readProductionBranch(session *rtl.AuthSession, projectID string) (*apiclient.GitBranch, error) {
+	prodClient := apiclient.NewLookerSDK(rtl.NewAuthSession(session.Config))
+	defer func() {
+		_, _ = prodClient.Logout(nil)
+	}()
+
+	if err := selectAPISession(prodClient, PROD_WORKSPACE); err != nil {
+		return nil, err
+	}
+
+	productionBranch, err := prodClient.GitBranch(projectID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &productionBranch, nil
+}
+
+// hasUncommittedGitChanges is always false for projects without git, which have no git status
+func hasUncommittedGitChanges(usesGit bool, status string) (bool, error) {
+	if !usesGit {
+		return false, nil
+	}
+	if status == "" {
+		return false, fmt.Errorf("Looker did not report the git status, unable to tell whether there are uncommitted changes")
+	}
+	return !isCleanGitStatus(status), nil
+}
+
+// Looker reports "clean" when the workspace has nothing to commit
+func isCleanGitStatus(status string) bool {
+	return strings.EqualFold(status, "clean")
+}
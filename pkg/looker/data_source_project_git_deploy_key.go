@@ -0,0 +1,70 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceProjectGitDeployKey() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProjectGitDeployKeyRead,
+		Description: "Reads the existing git deploy key of a project. Unlike the `looker_project_git_deploy_key` resource, it never generates a new key.",
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceProjectGitDeployKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	if err := selectAPISession(client, DEV_WORKSPACE); err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := d.Get("project_id").(string)
+
+	deployKey, err := client.GitDeployKey(projectID, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return diag.Errorf("project %q has no git deploy key, create one with the looker_project_git_deploy_key resource first", projectID)
+		}
+		return diag.FromErr(err)
+	}
+
+	publicKey, err := parsePublicKey(deployKey)
+	if err != nil {
+		return diag.Errorf("project %q: %v", projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	if err = d.Set("public_key", publicKey); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// parsePublicKey strips the comment from an ssh public key `<type> <key> [comment]`
+func parsePublicKey(raw string) (string, error) {
+	key := strings.Fields(raw)
+	if len(key) < 2 {
+		return "", fmt.Errorf("unexpected git deploy key format: %q", raw)
+	}
+	return fmt.Sprintf("%s %s", key[0], key[1]), nil
+}
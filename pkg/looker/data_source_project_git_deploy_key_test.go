@@ -0,0 +1,43 @@
+package looker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePublicKey(t *testing.T) {
+	tests := map[string]struct {
+		raw     string
+		wantRes string
+		wantErr bool
+	}{
+		"key with comment": {
+			raw:     "ssh-rsa AAAAB3NzaC1yc2E looker@example.com\n",
+			wantRes: "ssh-rsa AAAAB3NzaC1yc2E",
+			wantErr: false,
+		},
+		"key without comment": {
+			raw:     "ssh-rsa AAAAB3NzaC1yc2E",
+			wantRes: "ssh-rsa AAAAB3NzaC1yc2E",
+			wantErr: false,
+		},
+		"empty body": {
+			raw:     "",
+			wantErr: true,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			actual, err := parsePublicKey(tt.raw)
+			if tt.wantErr {
+				a.Error(err)
+			} else {
+				a.NoError(err)
+				a.Equal(tt.wantRes, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_DataSourceProject(t *testing.T) {
+	name := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dataSourceProjectConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_project.test", "name", name),
+					resource.TestCheckResourceAttr("data.looker_project.test", "uses_git", "false"),
+					resource.TestCheckResourceAttr("data.looker_project.test", "has_uncommitted_changes", "false"),
+					resource.TestCheckResourceAttr("data.looker_project.test", "production_ref", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestIsCleanGitStatus(t *testing.T) {
+	tests := map[string]struct {
+		status  string
+		wantRes bool
+	}{
+		"empty status": {
+			status:  "",
+			wantRes: false,
+		},
+		"clean status": {
+			status:  "clean",
+			wantRes: true,
+		},
+		"capitalized clean status": {
+			status:  "Clean",
+			wantRes: true,
+		},
+		"uncommitted changes": {
+			status:  "uncommitted changes",
+			wantRes: false,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			assert.Equal(t, tt.wantRes, isCleanGitStatus(tt.status))
+		})
+	}
+}
+
+func TestHasUncommittedGitChanges(t *testing.T) {
+	tests := map[string]struct {
+		usesGit bool
+		status  string
+		wantRes bool
+		wantErr bool
+	}{
+		"project without git": {
+			usesGit: false,
+			status:  "",
+			wantRes: false,
+		},
+		"clean workspace": {
+			usesGit: true,
+			status:  "clean",
+			wantRes: false,
+		},
+		"uncommitted changes": {
+			usesGit: true,
+			status:  "uncommitted changes",
+			wantRes: true,
+		},
+		"unknown status": {
+			usesGit: true,
+			status:  "",
+			wantErr: true,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			res, err := hasUncommittedGitChanges(tt.usesGit, tt.status)
+			if tt.wantErr {
+				a.Error(err)
+			} else {
+				a.NoError(err)
+				a.Equal(tt.wantRes, res)
+			}
+		})
+	}
+}
+
+func dataSourceProjectConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "looker_project" "test" {
+		name = "%s"
+	}
+	data "looker_project" "test" {
+		project_id = looker_project.test.id
+	}
+	`, name)
+}
@@ -0,0 +1,409 @@
+package looker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const maskedValue = "****"
+
+func dataSourceScheduledPlans() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScheduledPlansRead,
+		Description: "Lists the scheduled plans of a user or of a dashboard, look or LookML dashboard. Without any filter all scheduled plans of the instance are listed.",
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Description: "Only list scheduled plans owned by this user",
+				Optional:    true,
+			},
+			"dashboard_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"look_id", "lookml_dashboard_id"},
+			},
+			"look_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"dashboard_id", "lookml_dashboard_id"},
+			},
+			"lookml_dashboard_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"dashboard_id", "look_id"},
+			},
+			"scheduled_plans": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dashboard_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"look_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lookml_dashboard_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"crontab": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datagroup": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_run_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_run_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_run_status": {
+							Type:        schema.TypeString,
+							Description: "Status of the latest scheduled job, read from System Activity. Empty when the plan never ran or System Activity is not accessible.",
+							Computed:    true,
+						},
+						"destinations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"format": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"address": {
+										Type:        schema.TypeString,
+										Description: "Address of the destination with any credentials masked",
+										Computed:    true,
+									},
+									"parameters": {
+										Type:        schema.TypeMap,
+										Description: "Parameters of the destination with secret values masked",
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScheduledPlansRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	var userID *string
+	if v, ok := d.GetOk("user_id"); ok {
+		id := v.(string)
+		userID = &id
+	}
+	allUsers := userID == nil
+
+	var scheduledPlans []apiclient.ScheduledPlan
+	var err error
+	id := "all"
+	if v, ok := d.GetOk("dashboard_id"); ok {
+		id = "dashboard:" + v.(string)
+		scheduledPlans, err = client.ScheduledPlansForDashboard(apiclient.RequestScheduledPlansForDashboard{
+			DashboardId: v.(string),
+			UserId:      userID,
+			AllUsers:    &allUsers,
+		}, nil)
+	} else if v, ok := d.GetOk("look_id"); ok {
+		id = "look:" + v.(string)
+		scheduledPlans, err = client.ScheduledPlansForLook(apiclient.RequestScheduledPlansForLook{
+			LookId:   v.(string),
+			UserId:   userID,
+			AllUsers: &allUsers,
+		}, nil)
+	} else if v, ok := d.GetOk("lookml_dashboard_id"); ok {
+		id = "lookml_dashboard:" + v.(string)
+		scheduledPlans, err = client.ScheduledPlansForLookmlDashboard(apiclient.RequestScheduledPlansForLookmlDashboard{
+			LookmlDashboardId: v.(string),
+			UserId:            userID,
+			AllUsers:          &allUsers,
+		}, nil)
+	} else {
+		scheduledPlans, err = client.AllScheduledPlans(apiclient.RequestAllScheduledPlans{
+			UserId:   userID,
+			AllUsers: &allUsers,
+		}, nil)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if userID != nil {
+		id = fmt.Sprintf("%s/user:%s", id, *userID)
+	}
+
+	var diags diag.Diagnostics
+	var planIDs []string
+	for _, scheduledPlan := range scheduledPlans {
+		if scheduledPlan.LastRunAt != nil {
+			planIDs = append(planIDs, stringValue(scheduledPlan.Id))
+		}
+	}
+	statuses, err := lastScheduledJobStatuses(client, planIDs)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unable to read the last run status of scheduled plans",
+			Detail:   fmt.Sprintf("Reading System Activity failed: %v", err),
+		})
+	}
+
+	d.SetId(id)
+
+	plans, err := flattenScheduledPlans(scheduledPlans, statuses)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err = d.Set("scheduled_plans", plans); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// lastScheduledJobStatuses queries System Activity once for the status of the latest job
+// of every scheduled plan, keyed by scheduled plan ID
+func lastScheduledJobStatuses(client *apiclient.LookerSDK, scheduledPlanIDs []string) (map[string]string, error) {
+	if len(scheduledPlanIDs) == 0 {
+		return map[string]string{}, nil
+	}
+
+	fields := []string{"scheduled_job.scheduled_plan_id", "scheduled_job.status", "scheduled_job.finalized_time"}
+	filters := map[string]interface{}{"scheduled_job.scheduled_plan_id": strings.Join(scheduledPlanIDs, ",")}
+	sorts := []string{"scheduled_job.finalized_time desc"}
+	limit := "5000"
+
+	result, err := client.RunInlineQuery(apiclient.RequestRunInlineQuery{
+		ResultFormat: "json",
+		Body: apiclient.WriteQuery{
+			Model:   "system__activity",
+			View:    "scheduled_plan",
+			Fields:  &fields,
+			Filters: &filters,
+			Sorts:   &sorts,
+			Limit:   &limit,
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := flattenQueryRows(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return latestScheduledJobStatuses(rows), nil
+}
+
+// latestScheduledJobStatuses keeps the status of the first row of every scheduled plan,
+// the rows are sorted by the finalized time of the job, latest first
+func latestScheduledJobStatuses(rows []map[string]string) map[string]string {
+	statuses := map[string]string{}
+	for _, row := range rows {
+		planID := row["scheduled_job.scheduled_plan_id"]
+		if _, ok := statuses[planID]; !ok {
+			statuses[planID] = row["scheduled_job.status"]
+		}
+	}
+	return statuses
+}
+
+func flattenScheduledPlans(scheduledPlans []apiclient.ScheduledPlan, statuses map[string]string) ([]map[string]interface{}, error) {
+	result := make([]map[string]interface{}, 0, len(scheduledPlans))
+	for _, scheduledPlan := range scheduledPlans {
+		var userDisplayName string
+		if scheduledPlan.User != nil {
+			userDisplayName = stringValue(scheduledPlan.User.DisplayName)
+		}
+
+		destinations, err := flattenScheduledPlanDestinations(scheduledPlan.ScheduledPlanDestination)
+		if err != nil {
+			return nil, fmt.Errorf("scheduled plan %s: %v", stringValue(scheduledPlan.Id), err)
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                  stringValue(scheduledPlan.Id),
+			"name":                stringValue(scheduledPlan.Name),
+			"enabled":             boolValue(scheduledPlan.Enabled),
+			"user_id":             stringValue(scheduledPlan.UserId),
+			"user_display_name":   userDisplayName,
+			"dashboard_id":        stringValue(scheduledPlan.DashboardId),
+			"look_id":             stringValue(scheduledPlan.LookId),
+			"lookml_dashboard_id": stringValue(scheduledPlan.LookmlDashboardId),
+			"crontab":             stringValue(scheduledPlan.Crontab),
+			"datagroup":           stringValue(scheduledPlan.Datagroup),
+			"timezone":            stringValue(scheduledPlan.Timezone),
+			"next_run_at":         formatTime(scheduledPlan.NextRunAt),
+			"last_run_at":         formatTime(scheduledPlan.LastRunAt),
+			"last_run_status":     statuses[stringValue(scheduledPlan.Id)],
+			"destinations":        destinations,
+		})
+	}
+
+	return result, nil
+}
+
+func flattenScheduledPlanDestinations(destinations *[]apiclient.ScheduledPlanDestination) ([]map[string]interface{}, error) {
+	if destinations == nil {
+		return nil, nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(*destinations))
+	for _, destination := range *destinations {
+		parameters, err := maskDestinationParameters(stringValue(destination.Parameters))
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, map[string]interface{}{
+			"type":       stringValue(destination.Type),
+			"format":     stringValue(destination.Format),
+			"address":    maskDestinationAddress(stringValue(destination.Address)),
+			"parameters": parameters,
+		})
+	}
+
+	return result, nil
+}
+
+func isSecretParameterKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, secret := range []string{"secret", "password", "token", "private_key"} {
+		if strings.Contains(key, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskDestinationParameters decodes the JSON parameters of a destination and masks the values of secret keys
+func maskDestinationParameters(parameters string) (map[string]string, error) {
+	result, err := decodeDestinationParameters(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	for key := range result {
+		if isSecretParameterKey(key) {
+			result[key] = maskedValue
+		}
+	}
+
+	return result, nil
+}
+
+// decodeDestinationParameters decodes the JSON parameters of a destination, encoding non string values as JSON
+func decodeDestinationParameters(parameters string) (map[string]string, error) {
+	if parameters == "" {
+		return map[string]string{}, nil
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(parameters), &raw); err != nil {
+		return nil, fmt.Errorf("unexpected destination parameters: %v", err)
+	}
+
+	result := make(map[string]string, len(raw))
+	for key, value := range raw {
+		switch v := value.(type) {
+		case string:
+			result[key] = v
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = string(b)
+		}
+	}
+
+	return result, nil
+}
+
+// maskDestinationAddress masks passwords and secret query parameters of URL addresses
+func maskDestinationAddress(address string) string {
+	u, err := url.Parse(address)
+	if err != nil || u.Scheme == "" || u.Opaque != "" {
+		return address
+	}
+
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), maskedValue)
+	}
+
+	query := u.Query()
+	masked := false
+	for key := range query {
+		if isSecretParameterKey(key) {
+			query.Set(key, maskedValue)
+			masked = true
+		}
+	}
+	if masked {
+		u.RawQuery = query.Encode()
+	}
+
+	// url escapes the mask, keep it readable
+	return strings.ReplaceAll(u.String(), url.QueryEscape(maskedValue), maskedValue)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
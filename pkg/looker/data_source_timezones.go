@@ -0,0 +1,77 @@
+package looker
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceTimezones() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTimezonesRead,
+		Description: "Lists the timezones supported by the Looker instance.",
+		Schema: map[string]*schema.Schema{
+			"values": {
+				Type:        schema.TypeList,
+				Description: "Timezone values as accepted by timezone settings, e.g. `America/Los_Angeles`",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"timezones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTimezonesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	timezones, err := client.AllTimezones(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(session.Config.BaseUrl)
+
+	values := make([]string, 0, len(timezones))
+	result := make([]map[string]interface{}, 0, len(timezones))
+	for _, timezone := range timezones {
+		values = append(values, stringValue(timezone.Value))
+		result = append(result, map[string]interface{}{
+			"value": stringValue(timezone.Value),
+			"label": stringValue(timezone.Label),
+			"group": stringValue(timezone.Group),
+		})
+	}
+
+	if err = d.Set("values", values); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("timezones", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package looker
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceUserAttribute() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserAttributeRead,
+		Description: "Resolves a user attribute, including system and IdP-managed ones, by name.",
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value_is_hidden": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"user_can_view": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"user_can_edit": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_system": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_permanent": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceUserAttributeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	name := d.Get("name").(string)
+
+	userAttributes, err := client.AllUserAttributes(apiclient.RequestAllBoardSections{}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userAttribute, ok := findUserAttributeByName(userAttributes, name)
+	if !ok {
+		return diag.Errorf("user attribute %q not found", name)
+	}
+
+	d.SetId(*userAttribute.Id)
+
+	if err = d.Set("type", userAttribute.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("label", userAttribute.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("default_value", userAttribute.DefaultValue); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("value_is_hidden", userAttribute.ValueIsHidden); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_can_view", userAttribute.UserCanView); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_can_edit", userAttribute.UserCanEdit); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_system", userAttribute.IsSystem); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_permanent", userAttribute.IsPermanent); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func findUserAttributeByName(userAttributes []apiclient.UserAttribute, name string) (apiclient.UserAttribute, bool) {
+	for _, userAttribute := range userAttributes {
+		if userAttribute.Name == name {
+			return userAttribute, true
+		}
+	}
+	return apiclient.UserAttribute{}, false
+}
@@ -0,0 +1,68 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_DataSourceUserAttribute(t *testing.T) {
+	name := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dataSourceUserAttributeConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.looker_user_attribute.test", "id", "looker_user_attribute.test", "id"),
+					resource.TestCheckResourceAttr("data.looker_user_attribute.test", "type", "string"),
+					resource.TestCheckResourceAttr("data.looker_user_attribute.test", "label", name),
+				),
+			},
+			{
+				Config: `data "looker_user_attribute" "email" { name = "email" }`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_user_attribute.email", "is_system", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindUserAttributeByName(t *testing.T) {
+	id1 := "1"
+	id2 := "2"
+	userAttributes := []apiclient.UserAttribute{
+		{Id: &id1, Name: "email"},
+		{Id: &id2, Name: "locale"},
+	}
+
+	a := assert.New(t)
+
+	userAttribute, ok := findUserAttributeByName(userAttributes, "locale")
+	a.True(ok)
+	a.Equal("2", *userAttribute.Id)
+
+	_, ok = findUserAttributeByName(userAttributes, "Locale")
+	a.False(ok)
+}
+
+func dataSourceUserAttributeConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "looker_user_attribute" "test" {
+		name  = "%s"
+		type  = "string"
+		label = "%s"
+	}
+	data "looker_user_attribute" "test" {
+		name = looker_user_attribute.test.name
+	}
+	`, name, name)
+}
@@ -0,0 +1,397 @@
+package looker
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func dataSourceUserEffectiveAccess() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserEffectiveAccessRead,
+		Description: "Computes what a user can actually do by combining the roles granted directly, the roles granted to the user's groups and the roles granted to the groups containing those groups.",
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_ids": {
+				Type:        schema.TypeList,
+				Description: "Groups the user belongs to, directly or through nested groups",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"grants": {
+				Type:        schema.TypeList,
+				Description: "Every role that applies to the user together with the group it was inherited from",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_id": {
+							Type:        schema.TypeString,
+							Description: "Group the role is granted to, empty when the role is granted to the user directly",
+							Computed:    true,
+						},
+						"group_path": {
+							Type:        schema.TypeList,
+							Description: "Chain of group IDs from the user's own group up to `group_id`",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"model_set_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission_set_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permissions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"model_sets": {
+				Type:        schema.TypeList,
+				Description: "Effective permissions of the user per model set",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"model_set_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model_set_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"models": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"permissions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"role_ids": {
+							Type:        schema.TypeList,
+							Description: "Roles contributing to the permissions on this model set",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserEffectiveAccessRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	userID := d.Get("user_id").(string)
+
+	user, err := client.User(userID, "id,group_ids", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	directAssociationOnly := true
+	directRoles, err := client.UserRoles(apiclient.RequestUserRoles{
+		UserId:                userID,
+		DirectAssociationOnly: &directAssociationOnly,
+	}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var userGroupIDs []string
+	if user.GroupIds != nil {
+		userGroupIDs = *user.GroupIds
+	}
+
+	childGroups, roleGroups, err := readReachableGroups(client, userGroupIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var roles []apiclient.Role
+	if len(roleGroups) > 0 {
+		roleIDs := make(rtl.DelimString, 0, len(roleGroups))
+		for roleID := range roleGroups {
+			roleIDs = append(roleIDs, roleID)
+		}
+		sort.Strings(roleIDs)
+
+		roles, err = client.AllRoles(apiclient.RequestAllRoles{Ids: &roleIDs}, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	groupPaths := resolveGroupPaths(userGroupIDs, childGroups)
+	grants := collectAccessGrants(directRoles, roles, roleGroups, groupPaths)
+
+	d.SetId(userID)
+
+	effectiveGroupIDs := make([]string, 0, len(groupPaths))
+	for groupID := range groupPaths {
+		effectiveGroupIDs = append(effectiveGroupIDs, groupID)
+	}
+	sort.Strings(effectiveGroupIDs)
+
+	if err = d.Set("group_ids", effectiveGroupIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("grants", flattenAccessGrants(grants)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("model_sets", flattenModelSetAccess(grants)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// readReachableGroups walks up from the user's own groups, one search per level of nesting,
+// and returns the child groups of every reachable group and the reachable groups of every role
+func readReachableGroups(client *apiclient.LookerSDK, userGroupIDs []string) (map[string][]string, map[string][]string, error) {
+	childGroups := map[string][]string{}
+	roleGroups := map[string][]string{}
+
+	visited := map[string]bool{}
+	var pending []string
+	for _, groupID := range userGroupIDs {
+		if !visited[groupID] {
+			visited[groupID] = true
+			pending = append(pending, groupID)
+		}
+	}
+
+	for len(pending) > 0 {
+		ids := strings.Join(pending, ",")
+		fields := "id,parent_group_ids,role_ids"
+		groups, err := client.SearchGroupsWithHierarchy(apiclient.RequestSearchGroups{
+			Fields: &fields,
+			Id:     &ids,
+		}, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pending = nil
+		for _, group := range groups {
+			groupID := stringValue(group.Id)
+			if group.RoleIds != nil {
+				for _, roleID := range *group.RoleIds {
+					roleGroups[roleID] = append(roleGroups[roleID], groupID)
+				}
+			}
+			if group.ParentGroupIds == nil {
+				continue
+			}
+			for _, parentID := range *group.ParentGroupIds {
+				childGroups[parentID] = append(childGroups[parentID], groupID)
+				if !visited[parentID] {
+					visited[parentID] = true
+					pending = append(pending, parentID)
+				}
+			}
+		}
+	}
+
+	return childGroups, roleGroups, nil
+}
+
+// accessGrant is a role that applies to a user, either directly or through a group
+type accessGrant struct {
+	role      apiclient.Role
+	groupID   string
+	groupPath []string
+}
+
+// resolveGroupPaths returns every group the user is a member of, keyed by group ID,
+// with the chain of nested groups leading from one of the user's own groups to it
+func resolveGroupPaths(userGroupIDs []string, childGroups map[string][]string) map[string][]string {
+	parentGroups := map[string][]string{}
+	for parentID, children := range childGroups {
+		for _, childID := range children {
+			parentGroups[childID] = append(parentGroups[childID], parentID)
+		}
+	}
+	for childID := range parentGroups {
+		sort.Strings(parentGroups[childID])
+	}
+
+	paths := map[string][]string{}
+	queue := make([]string, 0, len(userGroupIDs))
+	for _, groupID := range userGroupIDs {
+		if _, ok := paths[groupID]; ok {
+			continue
+		}
+		paths[groupID] = []string{groupID}
+		queue = append(queue, groupID)
+	}
+
+	for len(queue) > 0 {
+		groupID := queue[0]
+		queue = queue[1:]
+		for _, parentID := range parentGroups[groupID] {
+			if _, ok := paths[parentID]; ok {
+				continue
+			}
+			path := make([]string, len(paths[groupID]), len(paths[groupID])+1)
+			copy(path, paths[groupID])
+			paths[parentID] = append(path, parentID)
+			queue = append(queue, parentID)
+		}
+	}
+
+	return paths
+}
+
+// collectAccessGrants lists the direct roles first, then the roles of every group in groupPaths
+func collectAccessGrants(directRoles, roles []apiclient.Role, roleGroups map[string][]string, groupPaths map[string][]string) []accessGrant {
+	var grants []accessGrant
+	for _, role := range directRoles {
+		grants = append(grants, accessGrant{role: role})
+	}
+
+	sortedRoles := make([]apiclient.Role, len(roles))
+	copy(sortedRoles, roles)
+	sort.SliceStable(sortedRoles, func(i, j int) bool {
+		return stringValue(sortedRoles[i].Id) < stringValue(sortedRoles[j].Id)
+	})
+
+	for _, role := range sortedRoles {
+		groupIDs := append([]string{}, roleGroups[stringValue(role.Id)]...)
+		sort.Strings(groupIDs)
+		for _, groupID := range groupIDs {
+			path, ok := groupPaths[groupID]
+			if !ok {
+				continue
+			}
+			grants = append(grants, accessGrant{role: role, groupID: groupID, groupPath: path})
+		}
+	}
+
+	return grants
+}
+
+func rolePermissions(role apiclient.Role) []string {
+	if role.PermissionSet == nil || role.PermissionSet.Permissions == nil {
+		return []string{}
+	}
+	permissions := append([]string{}, *role.PermissionSet.Permissions...)
+	sort.Strings(permissions)
+	return permissions
+}
+
+func flattenAccessGrants(grants []accessGrant) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(grants))
+	for _, grant := range grants {
+		var modelSetID, permissionSetID string
+		if grant.role.ModelSet != nil {
+			modelSetID = stringValue(grant.role.ModelSet.Id)
+		}
+		if grant.role.PermissionSet != nil {
+			permissionSetID = stringValue(grant.role.PermissionSet.Id)
+		}
+
+		result = append(result, map[string]interface{}{
+			"role_id":           stringValue(grant.role.Id),
+			"role_name":         stringValue(grant.role.Name),
+			"group_id":          grant.groupID,
+			"group_path":        grant.groupPath,
+			"model_set_id":      modelSetID,
+			"permission_set_id": permissionSetID,
+			"permissions":       rolePermissions(grant.role),
+		})
+	}
+
+	return result
+}
+
+// flattenModelSetAccess merges the permissions of all grants sharing a model set
+func flattenModelSetAccess(grants []accessGrant) []map[string]interface{} {
+	type modelSetAccess struct {
+		name        string
+		models      []string
+		permissions map[string]bool
+		roleIDs     map[string]bool
+	}
+
+	modelSets := map[string]*modelSetAccess{}
+	for _, grant := range grants {
+		if grant.role.ModelSet == nil {
+			continue
+		}
+		modelSetID := stringValue(grant.role.ModelSet.Id)
+		access, ok := modelSets[modelSetID]
+		if !ok {
+			access = &modelSetAccess{
+				name:        stringValue(grant.role.ModelSet.Name),
+				models:      []string{},
+				permissions: map[string]bool{},
+				roleIDs:     map[string]bool{},
+			}
+			if grant.role.ModelSet.Models != nil {
+				access.models = append(access.models, *grant.role.ModelSet.Models...)
+				sort.Strings(access.models)
+			}
+			modelSets[modelSetID] = access
+		}
+		for _, permission := range rolePermissions(grant.role) {
+			access.permissions[permission] = true
+		}
+		access.roleIDs[stringValue(grant.role.Id)] = true
+	}
+
+	modelSetIDs := make([]string, 0, len(modelSets))
+	for modelSetID := range modelSets {
+		modelSetIDs = append(modelSetIDs, modelSetID)
+	}
+	sort.Strings(modelSetIDs)
+
+	result := make([]map[string]interface{}, 0, len(modelSetIDs))
+	for _, modelSetID := range modelSetIDs {
+		access := modelSets[modelSetID]
+		result = append(result, map[string]interface{}{
+			"model_set_id":   modelSetID,
+			"model_set_name": access.name,
+			"models":         access.models,
+			"permissions":    sortedKeys(access.permissions),
+			"role_ids":       sortedKeys(access.roleIDs),
+		})
+	}
+
+	return result
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,209 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_DataSourceUserEffectiveAccess(t *testing.T) {
+	name := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dataSourceUserEffectiveAccessConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_user_effective_access.test", "grants.#", "2"),
+					resource.TestCheckResourceAttrPair("data.looker_user_effective_access.test", "grants.1.group_id", "looker_group.parent", "id"),
+					resource.TestCheckResourceAttrPair("data.looker_user_effective_access.test", "grants.1.group_path.0", "looker_group.child", "id"),
+					resource.TestCheckResourceAttr("data.looker_user_effective_access.test", "model_sets.#", "1"),
+					resource.TestCheckResourceAttr("data.looker_user_effective_access.test", "model_sets.0.permissions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func dataSourceUserEffectiveAccessConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "looker_user" "test" {
+		first_name = "Effective"
+		last_name  = "Access"
+		email      = "%[1]s@example.com"
+	}
+	resource "looker_model_set" "test" {
+		name   = "%[1]s-models"
+		models = ["thelook"]
+	}
+	resource "looker_permission_set" "direct" {
+		name        = "%[1]s-direct"
+		permissions = ["access_data"]
+	}
+	resource "looker_permission_set" "inherited" {
+		name        = "%[1]s-inherited"
+		permissions = ["access_data", "see_looks"]
+	}
+	resource "looker_role" "direct" {
+		name              = "%[1]s-direct"
+		permission_set_id = looker_permission_set.direct.id
+		model_set_id      = looker_model_set.test.id
+	}
+	resource "looker_role" "inherited" {
+		name              = "%[1]s-inherited"
+		permission_set_id = looker_permission_set.inherited.id
+		model_set_id      = looker_model_set.test.id
+	}
+	resource "looker_user_roles" "test" {
+		user_id  = looker_user.test.id
+		role_ids = [looker_role.direct.id]
+	}
+	resource "looker_group" "parent" {
+		name = "%[1]s-parent"
+	}
+	resource "looker_group" "child" {
+		name = "%[1]s-child"
+	}
+	resource "looker_group_membership" "parent" {
+		target_group_id = looker_group.parent.id
+		group_ids       = [looker_group.child.id]
+	}
+	resource "looker_group_membership" "child" {
+		target_group_id = looker_group.child.id
+		user_ids        = [looker_user.test.id]
+	}
+	resource "looker_role_groups" "inherited" {
+		role_id   = looker_role.inherited.id
+		group_ids = [looker_group.parent.id]
+	}
+	data "looker_user_effective_access" "test" {
+		user_id = looker_user.test.id
+
+		depends_on = [
+			looker_user_roles.test,
+			looker_group_membership.parent,
+			looker_group_membership.child,
+			looker_role_groups.inherited,
+		]
+	}
+	`, name)
+}
+
+func TestResolveGroupPaths(t *testing.T) {
+	tests := map[string]struct {
+		userGroupIDs []string
+		childGroups  map[string][]string
+		wantRes      map[string][]string
+	}{
+		"no groups": {
+			userGroupIDs: nil,
+			childGroups:  map[string][]string{"1": {"2"}},
+			wantRes:      map[string][]string{},
+		},
+		"direct groups only": {
+			userGroupIDs: []string{"1", "2"},
+			childGroups:  map[string][]string{},
+			wantRes:      map[string][]string{"1": {"1"}, "2": {"2"}},
+		},
+		"nested groups": {
+			userGroupIDs: []string{"3"},
+			childGroups:  map[string][]string{"1": {"2"}, "2": {"3"}, "4": {"5"}},
+			wantRes:      map[string][]string{"3": {"3"}, "2": {"3", "2"}, "1": {"3", "2", "1"}},
+		},
+		"shortest path wins": {
+			userGroupIDs: []string{"2", "3"},
+			childGroups:  map[string][]string{"1": {"2"}, "2": {"3"}},
+			wantRes:      map[string][]string{"3": {"3"}, "2": {"2"}, "1": {"2", "1"}},
+		},
+		"cycle": {
+			userGroupIDs: []string{"1"},
+			childGroups:  map[string][]string{"1": {"2"}, "2": {"1"}},
+			wantRes:      map[string][]string{"1": {"1"}, "2": {"1", "2"}},
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			a.Equal(tt.wantRes, resolveGroupPaths(tt.userGroupIDs, tt.childGroups))
+		})
+	}
+}
+
+func TestFlattenModelSetAccess(t *testing.T) {
+	newRole := func(id, modelSetID string, permissions ...string) apiclient.Role {
+		models := []string{"thelook"}
+		return apiclient.Role{
+			Id:            &id,
+			PermissionSet: &apiclient.PermissionSet{Permissions: &permissions},
+			ModelSet:      &apiclient.ModelSet{Id: &modelSetID, Models: &models},
+		}
+	}
+
+	tests := map[string]struct {
+		directRoles []apiclient.Role
+		roles       []apiclient.Role
+		roleGroups  map[string][]string
+		groupPaths  map[string][]string
+		wantGrants  int
+		wantRes     []map[string]interface{}
+	}{
+		"direct role only": {
+			directRoles: []apiclient.Role{newRole("1", "10", "see_looks", "access_data")},
+			wantGrants:  1,
+			wantRes: []map[string]interface{}{
+				{
+					"model_set_id":   "10",
+					"model_set_name": "",
+					"models":         []string{"thelook"},
+					"permissions":    []string{"access_data", "see_looks"},
+					"role_ids":       []string{"1"},
+				},
+			},
+		},
+		"direct and inherited roles are merged per model set": {
+			directRoles: []apiclient.Role{newRole("1", "10", "access_data")},
+			roles: []apiclient.Role{
+				newRole("1", "10", "access_data"),
+				newRole("2", "10", "access_data", "explore"),
+				newRole("3", "20", "see_lookml"),
+				newRole("4", "30", "develop"),
+			},
+			roleGroups: map[string][]string{"2": {"100"}, "3": {"200"}, "4": {"300"}},
+			groupPaths: map[string][]string{"100": {"100"}, "200": {"100", "200"}},
+			wantGrants: 3,
+			wantRes: []map[string]interface{}{
+				{
+					"model_set_id":   "10",
+					"model_set_name": "",
+					"models":         []string{"thelook"},
+					"permissions":    []string{"access_data", "explore"},
+					"role_ids":       []string{"1", "2"},
+				},
+				{
+					"model_set_id":   "20",
+					"model_set_name": "",
+					"models":         []string{"thelook"},
+					"permissions":    []string{"see_lookml"},
+					"role_ids":       []string{"3"},
+				},
+			},
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			grants := collectAccessGrants(tt.directRoles, tt.roles, tt.roleGroups, tt.groupPaths)
+			a.Len(grants, tt.wantGrants)
+			a.Equal(tt.wantRes, flattenModelSetAccess(grants))
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
 )
@@ -30,6 +31,60 @@ func selectAPISession(client *apiclient.LookerSDK, id string) error {
 	return err
 }
 
+// findFolderByPath resolves a folder path such as `Shared/Finance/Monthly`
+// by walking down from the root folder one name at a time.
+func findFolderByPath(client *apiclient.LookerSDK, path string) (apiclient.Folder, error) {
+	names := splitFolderPath(path)
+	if len(names) == 0 {
+		return apiclient.Folder{}, fmt.Errorf("invalid folder path: %q", path)
+	}
+
+	var folder apiclient.Folder
+	var parentID *string
+	for _, name := range names {
+		name := name
+		folders, err := client.SearchFolders(apiclient.RequestSearchFolders{
+			Name:     &name,
+			ParentId: parentID,
+		}, nil)
+		if err != nil {
+			return apiclient.Folder{}, err
+		}
+
+		found := false
+		for _, f := range folders {
+			// search matches names case-insensitively and root folders have no parent
+			if f.Name == name && (parentID != nil || f.ParentId == nil) {
+				folder = f
+				found = true
+				break
+			}
+		}
+		if !found {
+			return apiclient.Folder{}, fmt.Errorf("folder %q not found in path %q", name, path)
+		}
+		parentID = folder.Id
+	}
+
+	return folder, nil
+}
+
+// buildFolderPath returns the path of the folder from the root folder, e.g. `Shared/Finance/Monthly`
+func buildFolderPath(client *apiclient.LookerSDK, folder apiclient.Folder) (string, error) {
+	ancestors, err := client.FolderAncestors(*folder.Id, "name", nil)
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(ancestors)+1)
+	for _, ancestor := range ancestors {
+		names = append(names, ancestor.Name)
+	}
+	names = append(names, folder.Name)
+
+	return strings.Join(names, "/"), nil
+}
+
 func JSONMarshal(t interface{}) ([]byte, error) {
 	buffer := &bytes.Buffer{}
 	encoder := json.NewEncoder(buffer)
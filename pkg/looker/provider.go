@@ -67,6 +67,34 @@ func Provider() *schema.Provider {
 			"looker_project_git_deploy_key":     resourceProjectGitDeployKey(),
 			"looker_project_git_repo":           resourceProjectGitRepo(),
 			"looker_theme":                      resourceTheme(),
+			"looker_folder":                     resourceFolder(),
+			"looker_content_metadata_access":    resourceContentMetadataAccess(),
+			"looker_lookml_dashboard_sync":      resourceLookMLDashboardSync(),
+			"looker_dashboard":                  resourceDashboard(),
+			"looker_look":                       resourceLook(),
+			"looker_scheduled_plan":             resourceScheduledPlan(),
+			"looker_alert":                      resourceAlert(),
+			"looker_board":                      resourceBoard(),
+			"looker_user_api_credentials":       resourceUserAPICredentials(),
+			"looker_saml_config":                resourceSamlConfig(),
+			"looker_oidc_config":                resourceOidcConfig(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"looker_connection":             dataSourceConnection(),
+			"looker_dialects":               dataSourceDialects(),
+			"looker_lookml_model":           dataSourceLookMLModel(),
+			"looker_lookml_model_explore":   dataSourceLookMLModelExplore(),
+			"looker_project":                dataSourceProject(),
+			"looker_user_attribute":         dataSourceUserAttribute(),
+			"looker_folder":                 dataSourceFolder(),
+			"looker_instance":               dataSourceInstance(),
+			"looker_project_git_deploy_key": dataSourceProjectGitDeployKey(),
+			"looker_inline_query":           dataSourceInlineQuery(),
+			"looker_user_effective_access":  dataSourceUserEffectiveAccess(),
+			"looker_scheduled_plans":        dataSourceScheduledPlans(),
+			"looker_color_collection":       dataSourceColorCollection(),
+			"looker_timezones":              dataSourceTimezones(),
+			"looker_locales":                dataSourceLocales(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
@@ -0,0 +1,337 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceAlert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlertCreate,
+		ReadContext:   resourceAlertRead,
+		UpdateContext: resourceAlertUpdate,
+		DeleteContext: resourceAlertDelete,
+		CustomizeDiff: resourceAlertCustomizeDiff,
+		Description:   "Manages an alert on a field of a dashboard tile, sent when the field crosses a threshold.",
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"dashboard_element_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"field_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the field to check, in the format `view.field`",
+				Required:    true,
+			},
+			"field_title": {
+				Type:        schema.TypeString,
+				Description: "Title of the field. Defaults to the field name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"comparison_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apiclient.ComparisonType_EQUAL_TO),
+					string(apiclient.ComparisonType_GREATER_THAN),
+					string(apiclient.ComparisonType_GREATER_THAN_OR_EQUAL_TO),
+					string(apiclient.ComparisonType_LESS_THAN),
+					string(apiclient.ComparisonType_LESS_THAN_OR_EQUAL_TO),
+					string(apiclient.ComparisonType_INCREASES_BY),
+					string(apiclient.ComparisonType_DECREASES_BY),
+					string(apiclient.ComparisonType_CHANGES_BY),
+				}, false),
+			},
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"cron": {
+				Type:        schema.TypeString,
+				Description: "Vixie-style crontab specification of when to check. Checks must be at least 15 minutes apart.",
+				Required:    true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"owner_id": {
+				Type:        schema.TypeString,
+				Description: "Owner of the alert. Defaults to the API user.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"destination": {
+				Type:        schema.TypeList,
+				Description: "Destinations of the alert, which must all be of the same type",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(apiclient.DestinationType_EMAIL),
+								string(apiclient.DestinationType_ACTION_HUB),
+							}, false),
+						},
+						"email_address": {
+							Type:        schema.TypeString,
+							Description: "Recipient of EMAIL destinations, required for them",
+							Optional:    true,
+						},
+						"action_hub_integration_id": {
+							Type:        schema.TypeString,
+							Description: "Action hub integration, e.g. Slack, required for ACTION_HUB destinations",
+							Optional:    true,
+						},
+						"action_hub_form_params_json": {
+							Type:             schema.TypeString,
+							Description:      "JSON object of the form parameters of the action hub integration, e.g. the Slack channel",
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentJSONDiff,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlertCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return validateAlertDestinations(d.Get("destination").([]interface{}), d.NewValueKnown)
+}
+
+func resourceAlertCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	writeAlert := expandAlert(d)
+	if writeAlert.OwnerId == "" {
+		me, err := client.Me("id", nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		writeAlert.OwnerId = stringValue(me.Id)
+	}
+
+	alert, err := client.CreateAlert(writeAlert, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*alert.Id)
+
+	return resourceAlertRead(ctx, d, m)
+}
+
+func resourceAlertRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	alert, err := client.GetAlert(d.Id(), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("dashboard_element_id", alert.DashboardElementId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("field_name", alert.Field.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("field_title", alert.Field.Title); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("comparison_type", string(alert.ComparisonType)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("threshold", alert.Threshold); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("cron", alert.Cron); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("title", alert.CustomTitle); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", alert.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("owner_id", alert.OwnerId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("enabled", !boolValue(alert.IsDisabled)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("destination", flattenAlertDestinations(alert.Destinations)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAlertUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	// alerts are replaced as a whole on update
+	_, err := client.UpdateAlert(d.Id(), expandAlert(d), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAlertRead(ctx, d, m)
+}
+
+func resourceAlertDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	err := client.DeleteAlert(d.Id(), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil // already deleted outside of terraform
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandAlert(d *schema.ResourceData) apiclient.WriteAlert {
+	dashboardElementID := d.Get("dashboard_element_id").(string)
+	fieldName := d.Get("field_name").(string)
+	fieldTitle := d.Get("field_title").(string)
+	if fieldTitle == "" {
+		fieldTitle = fieldName
+	}
+	isDisabled := !d.Get("enabled").(bool)
+
+	writeAlert := apiclient.WriteAlert{
+		DashboardElementId: &dashboardElementID,
+		Field: apiclient.AlertField{
+			Name:  fieldName,
+			Title: fieldTitle,
+		},
+		ComparisonType: apiclient.ComparisonType(d.Get("comparison_type").(string)),
+		Threshold:      d.Get("threshold").(float64),
+		Cron:           d.Get("cron").(string),
+		OwnerId:        d.Get("owner_id").(string),
+		IsDisabled:     &isDisabled,
+		Destinations:   expandAlertDestinations(d.Get("destination").([]interface{})),
+	}
+	if v := d.Get("title").(string); v != "" {
+		writeAlert.CustomTitle = &v
+	}
+	if v := d.Get("description").(string); v != "" {
+		writeAlert.Description = &v
+	}
+
+	return writeAlert
+}
+
+// validateAlertDestinations checks that all destinations are of the same type and have the attributes of their type,
+// skipping values for which isKnown, called with keys such as destination.0.email_address, reports false
+func validateAlertDestinations(destinations []interface{}, isKnown func(key string) bool) error {
+	var destinationType string
+	for i, raw := range destinations {
+		if raw == nil {
+			continue
+		}
+		destination := raw.(map[string]interface{})
+
+		t := destination["type"].(string)
+		if t == "" {
+			continue // not known yet
+		}
+		if destinationType == "" {
+			destinationType = t
+		} else if t != destinationType {
+			return fmt.Errorf("all destinations of an alert must be of the same type, got %s and %s", destinationType, t)
+		}
+
+		switch apiclient.DestinationType(t) {
+		case apiclient.DestinationType_EMAIL:
+			if destination["action_hub_integration_id"].(string) != "" || destination["action_hub_form_params_json"].(string) != "" {
+				return fmt.Errorf("destination.%d: action hub attributes cannot be set for EMAIL destinations", i)
+			}
+			if destination["email_address"].(string) == "" && isKnown(fmt.Sprintf("destination.%d.email_address", i)) {
+				return fmt.Errorf("destination.%d: email_address is required for EMAIL destinations", i)
+			}
+		case apiclient.DestinationType_ACTION_HUB:
+			if destination["email_address"].(string) != "" {
+				return fmt.Errorf("destination.%d: email_address cannot be set for ACTION_HUB destinations", i)
+			}
+			if destination["action_hub_integration_id"].(string) == "" && isKnown(fmt.Sprintf("destination.%d.action_hub_integration_id", i)) {
+				return fmt.Errorf("destination.%d: action_hub_integration_id is required for ACTION_HUB destinations", i)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandAlertDestinations(raw []interface{}) []apiclient.AlertDestination {
+	destinations := make([]apiclient.AlertDestination, 0, len(raw))
+	for _, r := range raw {
+		destination := r.(map[string]interface{})
+
+		write := apiclient.AlertDestination{
+			DestinationType: apiclient.DestinationType(destination["type"].(string)),
+		}
+		for key, field := range map[string]**string{
+			"email_address":               &write.EmailAddress,
+			"action_hub_integration_id":   &write.ActionHubIntegrationId,
+			"action_hub_form_params_json": &write.ActionHubFormParamsJson,
+		} {
+			if v := destination[key].(string); v != "" {
+				*field = &v
+			}
+		}
+
+		destinations = append(destinations, write)
+	}
+
+	return destinations
+}
+
+func flattenAlertDestinations(destinations []apiclient.AlertDestination) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(destinations))
+	for _, destination := range destinations {
+		result = append(result, map[string]interface{}{
+			"type":                        string(destination.DestinationType),
+			"email_address":               stringValue(destination.EmailAddress),
+			"action_hub_integration_id":   stringValue(destination.ActionHubIntegrationId),
+			"action_hub_form_params_json": stringValue(destination.ActionHubFormParamsJson),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,205 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_Alert(t *testing.T) {
+	title := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: alertConfig(title, 100, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("looker_alert.test", "dashboard_element_id", "looker_dashboard.test", "element.0.id"),
+					resource.TestCheckResourceAttr("looker_alert.test", "field_title", "users.count"),
+					resource.TestCheckResourceAttr("looker_alert.test", "threshold", "100"),
+					resource.TestCheckResourceAttr("looker_alert.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("looker_alert.test", "owner_id"),
+					resource.TestCheckResourceAttr("looker_alert.test", "destination.#", "2"),
+				),
+			},
+			{
+				Config: alertConfig(title, 250.5, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_alert.test", "threshold", "250.5"),
+					resource.TestCheckResourceAttr("looker_alert.test", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "looker_alert.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccCheckAlertDestroy,
+	})
+}
+
+func testAccCheckAlertDestroy(s *terraform.State) error {
+	client := apiclient.NewLookerSDK(testAccProvider.Meta().(*rtl.AuthSession))
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_alert" {
+			continue
+		}
+
+		_, err := client.GetAlert(rs.Primary.ID, nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				continue // successfully destroyed
+			}
+			return err
+		}
+
+		return fmt.Errorf("alert still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func alertConfig(title string, threshold float64, enabled bool) string {
+	return fmt.Sprintf(`
+	data "looker_folder" "shared" {
+		path = "Shared"
+	}
+	resource "looker_dashboard" "test" {
+		title     = "%s"
+		folder_id = data.looker_folder.shared.id
+
+		element {
+			type  = "vis"
+			title = "Signups"
+			query {
+				model  = "thelook"
+				view   = "users"
+				fields = ["users.count"]
+			}
+		}
+	}
+	resource "looker_alert" "test" {
+		dashboard_element_id = looker_dashboard.test.element.0.id
+		field_name           = "users.count"
+		comparison_type      = "GREATER_THAN"
+		threshold            = %v
+		cron                 = "0 * * * *"
+		enabled              = %t
+
+		destination {
+			type          = "EMAIL"
+			email_address = "ops@example.com"
+		}
+
+		destination {
+			type          = "EMAIL"
+			email_address = "data-quality@example.com"
+		}
+	}
+	`, title, threshold, enabled)
+}
+
+func TestValidateAlertDestinations(t *testing.T) {
+	newDestination := func(destinationType, emailAddress, integrationID string) interface{} {
+		return map[string]interface{}{
+			"type":                        destinationType,
+			"email_address":               emailAddress,
+			"action_hub_integration_id":   integrationID,
+			"action_hub_form_params_json": "",
+		}
+	}
+
+	tests := map[string]struct {
+		destinations []interface{}
+		unknownKeys  map[string]bool
+		wantErr      bool
+	}{
+		"emails": {
+			destinations: []interface{}{newDestination("EMAIL", "a@example.com", ""), newDestination("EMAIL", "b@example.com", "")},
+			wantErr:      false,
+		},
+		"action hub": {
+			destinations: []interface{}{newDestination("ACTION_HUB", "", "1::slack_app")},
+			wantErr:      false,
+		},
+		"unknown type": {
+			destinations: []interface{}{newDestination("EMAIL", "a@example.com", ""), newDestination("", "", "")},
+			wantErr:      false,
+		},
+		"mixed types": {
+			destinations: []interface{}{newDestination("EMAIL", "a@example.com", ""), newDestination("ACTION_HUB", "", "1::slack_app")},
+			wantErr:      true,
+		},
+		"action hub attributes on email": {
+			destinations: []interface{}{newDestination("EMAIL", "a@example.com", "1::slack_app")},
+			wantErr:      true,
+		},
+		"email address on action hub": {
+			destinations: []interface{}{newDestination("ACTION_HUB", "a@example.com", "1::slack_app")},
+			wantErr:      true,
+		},
+		"email without address": {
+			destinations: []interface{}{newDestination("EMAIL", "a@example.com", ""), newDestination("EMAIL", "", "")},
+			wantErr:      true,
+		},
+		"action hub without integration": {
+			destinations: []interface{}{newDestination("ACTION_HUB", "", "")},
+			wantErr:      true,
+		},
+		"action hub integration not known yet": {
+			destinations: []interface{}{newDestination("ACTION_HUB", "", "")},
+			unknownKeys:  map[string]bool{"destination.0.action_hub_integration_id": true},
+			wantErr:      false,
+		},
+		"email address not known yet": {
+			destinations: []interface{}{newDestination("EMAIL", "", "")},
+			unknownKeys:  map[string]bool{"destination.0.email_address": true},
+			wantErr:      false,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			err := validateAlertDestinations(tt.destinations, func(key string) bool { return !tt.unknownKeys[key] })
+			if tt.wantErr {
+				a.Error(err)
+			} else {
+				a.NoError(err)
+			}
+		})
+	}
+}
+
+func TestExpandAlertDestinations(t *testing.T) {
+	a := assert.New(t)
+
+	raw := []interface{}{
+		map[string]interface{}{
+			"type":                        "ACTION_HUB",
+			"email_address":               "",
+			"action_hub_integration_id":   "1::slack_app",
+			"action_hub_form_params_json": `{"channel":"#data-quality"}`,
+		},
+	}
+
+	destinations := expandAlertDestinations(raw)
+	a.Len(destinations, 1)
+	a.Equal(apiclient.DestinationType_ACTION_HUB, destinations[0].DestinationType)
+	a.Nil(destinations[0].EmailAddress)
+	a.Equal("1::slack_app", *destinations[0].ActionHubIntegrationId)
+
+	flattened := flattenAlertDestinations(destinations)
+	a.Equal(raw[0], interface{}(flattened[0]))
+}
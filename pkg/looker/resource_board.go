@@ -0,0 +1,512 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceBoard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBoardCreate,
+		ReadContext:   resourceBoardRead,
+		UpdateContext: resourceBoardUpdate,
+		DeleteContext: resourceBoardDelete,
+		CustomizeDiff: resourceBoardCustomizeDiff,
+		Description:   "Manages a board with its sections and items. Sections and items not in the configuration are removed and the remaining ones are reordered to match the configuration.",
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_metadata_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"section": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"item": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"dashboard_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"look_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"lookml_dashboard_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"url": {
+										Type:        schema.TypeString,
+										Description: "URL of a link item. Exactly one of dashboard_id, look_id, lookml_dashboard_id and url must be set.",
+										Optional:    true,
+									},
+									"title": {
+										Type:        schema.TypeString,
+										Description: "Custom title replacing the title of the content. Required for link items.",
+										Optional:    true,
+									},
+									"description": {
+										Type:        schema.TypeString,
+										Description: "Custom description replacing the description of the content",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBoardCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return validateBoardSections(d.Get("section").([]interface{}), d.NewValueKnown)
+}
+
+func resourceBoardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	board, err := client.CreateBoard(expandBoard(d), "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*board.Id)
+
+	if err = syncBoardSections(client, d.Id(), d.Get("section").([]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceBoardRead(ctx, d, m)
+}
+
+func resourceBoardRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	board, err := client.Board(d.Id(), "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if board.DeletedAt != nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("title", board.Title); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", board.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_metadata_id", board.ContentMetadataId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("section", flattenBoardSections(orderBoardSections(board.BoardSections, board.SectionOrder))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceBoardUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	if d.HasChanges("title", "description") {
+		if _, err := client.UpdateBoard(d.Id(), expandBoard(d), "", nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("section") {
+		if err := syncBoardSections(client, d.Id(), d.Get("section").([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceBoardRead(ctx, d, m)
+}
+
+func resourceBoardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	_, err := client.DeleteBoard(d.Id(), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil // already deleted outside of terraform
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandBoard(d *schema.ResourceData) apiclient.WriteBoard {
+	title := d.Get("title").(string)
+	description := d.Get("description").(string)
+
+	return apiclient.WriteBoard{
+		Title:       &title,
+		Description: &description,
+	}
+}
+
+// syncBoardSections converges the sections of the board to the configuration. Sections are matched by
+// position, items within a section by the content they point at, so reordering items keeps them.
+func syncBoardSections(client *apiclient.LookerSDK, boardID string, desired []interface{}) error {
+	board, err := client.Board(boardID, "board_sections,section_order", nil)
+	if err != nil {
+		return err
+	}
+	current := orderBoardSections(board.BoardSections, board.SectionOrder)
+
+	sectionIDs := make([]string, 0, len(desired))
+	for i, raw := range desired {
+		section, _ := raw.(map[string]interface{})
+		title, _ := section["title"].(string)
+		description, _ := section["description"].(string)
+		items, _ := section["item"].([]interface{})
+		writeSection := apiclient.WriteBoardSection{
+			BoardId:     &boardID,
+			Title:       &title,
+			Description: &description,
+		}
+
+		var sectionID string
+		var currentItems []apiclient.BoardItem
+		if i < len(current) {
+			sectionID = stringValue(current[i].Id)
+			currentItems = orderBoardItems(current[i].BoardItems, current[i].ItemOrder)
+			if stringValue(current[i].Title) != title || stringValue(current[i].Description) != description {
+				if _, err = client.UpdateBoardSection(sectionID, writeSection, "", nil); err != nil {
+					return err
+				}
+			}
+		} else {
+			created, err := client.CreateBoardSection(writeSection, "", nil)
+			if err != nil {
+				return err
+			}
+			sectionID = *created.Id
+		}
+
+		itemIDs, err := syncBoardItems(client, sectionID, currentItems, items)
+		if err != nil {
+			return fmt.Errorf("section.%d: %v", i, err)
+		}
+		if _, err = client.UpdateBoardSection(sectionID, apiclient.WriteBoardSection{ItemOrder: &itemIDs}, "", nil); err != nil {
+			return err
+		}
+
+		sectionIDs = append(sectionIDs, sectionID)
+	}
+
+	for _, section := range current[minInt(len(desired), len(current)):] {
+		if _, err = client.DeleteBoardSection(stringValue(section.Id), nil); err != nil {
+			return err
+		}
+	}
+
+	_, err = client.UpdateBoard(boardID, apiclient.WriteBoard{SectionOrder: &sectionIDs}, "", nil)
+	return err
+}
+
+// syncBoardItems converges the items of a section and returns their IDs in the configured order
+func syncBoardItems(client *apiclient.LookerSDK, sectionID string, current []apiclient.BoardItem, desired []interface{}) ([]string, error) {
+	items := make([]map[string]interface{}, 0, len(desired))
+	for _, raw := range desired {
+		item, _ := raw.(map[string]interface{})
+		items = append(items, item)
+	}
+
+	currentByID := map[string]map[string]interface{}{}
+	for _, item := range current {
+		currentByID[stringValue(item.Id)] = flattenBoardItem(item)
+	}
+
+	matched, deletes := matchBoardItems(current, items)
+
+	for _, id := range deletes {
+		if _, err := client.DeleteBoardItem(id, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	itemIDs := make([]string, 0, len(items))
+	for i, item := range items {
+		writeItem := expandBoardItem(item)
+		if matched[i] == "" {
+			writeItem.BoardSectionId = &sectionID
+			created, err := client.CreateBoardItem(writeItem, "", nil)
+			if err != nil {
+				return nil, err
+			}
+			itemIDs = append(itemIDs, *created.Id)
+			continue
+		}
+
+		existing := currentByID[matched[i]]
+		if existing["title"] != item["title"] || existing["description"] != item["description"] {
+			if _, err := client.UpdateBoardItem(matched[i], writeItem, "", nil); err != nil {
+				return nil, err
+			}
+		}
+		itemIDs = append(itemIDs, matched[i])
+	}
+
+	return itemIDs, nil
+}
+
+// matchBoardItems pairs each desired item with an existing item pointing at the same content and returns
+// the ID of the paired item per desired item, empty when it has to be created, and the IDs of unpaired items
+func matchBoardItems(current []apiclient.BoardItem, desired []map[string]interface{}) ([]string, []string) {
+	available := map[string][]string{}
+	for _, item := range current {
+		key := boardItemKey(flattenBoardItem(item))
+		available[key] = append(available[key], stringValue(item.Id))
+	}
+
+	matched := make([]string, len(desired))
+	isMatched := map[string]bool{}
+	for i, item := range desired {
+		key := boardItemKey(item)
+		if ids := available[key]; len(ids) > 0 {
+			matched[i] = ids[0]
+			available[key] = ids[1:]
+			isMatched[ids[0]] = true
+		}
+	}
+
+	var deletes []string
+	for _, item := range current {
+		if id := stringValue(item.Id); !isMatched[id] {
+			deletes = append(deletes, id)
+		}
+	}
+
+	return matched, deletes
+}
+
+// boardItemKey identifies the content an item points at
+func boardItemKey(item map[string]interface{}) string {
+	for _, key := range []string{"dashboard_id", "look_id", "lookml_dashboard_id", "url"} {
+		if v, _ := item[key].(string); v != "" {
+			return key + ":" + v
+		}
+	}
+	return ""
+}
+
+// validateBoardSections skips the values that are not known yet, isKnown reports
+// whether the value of an attribute such as section.0.item.0.url is known
+func validateBoardSections(sections []interface{}, isKnown func(key string) bool) error {
+	for i, rawSection := range sections {
+		section, ok := rawSection.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for j, rawItem := range section["item"].([]interface{}) {
+			item, ok := rawItem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			prefix := fmt.Sprintf("section.%d.item.%d", i, j)
+			if err := validateBoardItem(item, prefix, isKnown); err != nil {
+				return fmt.Errorf("%s: %v", prefix, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateBoardItem(item map[string]interface{}, prefix string, isKnown func(key string) bool) error {
+	var targets []string
+	unknown := 0
+	for _, key := range []string{"dashboard_id", "look_id", "lookml_dashboard_id", "url"} {
+		if v, _ := item[key].(string); v != "" {
+			targets = append(targets, key)
+		} else if !isKnown(prefix + "." + key) {
+			unknown++
+		}
+	}
+	if len(targets) > 1 || len(targets)+unknown == 0 {
+		return fmt.Errorf("exactly one of dashboard_id, look_id, lookml_dashboard_id and url must be set, got %d", len(targets))
+	}
+	if len(targets) == 0 {
+		return nil // the content is not known yet
+	}
+	if v, _ := item["title"].(string); targets[0] == "url" && v == "" && isKnown(prefix+".title") {
+		return fmt.Errorf("title must be set for url items")
+	}
+	return nil
+}
+
+func expandBoardItem(item map[string]interface{}) apiclient.WriteBoardItem {
+	var writeItem apiclient.WriteBoardItem
+	for key, field := range map[string]**string{
+		"dashboard_id":        &writeItem.DashboardId,
+		"look_id":             &writeItem.LookId,
+		"lookml_dashboard_id": &writeItem.LookmlDashboardId,
+	} {
+		if v, _ := item[key].(string); v != "" {
+			*field = &v
+		}
+	}
+
+	customURL, _ := item["url"].(string)
+	customTitle, _ := item["title"].(string)
+	customDescription, _ := item["description"].(string)
+	useCustomURL := customURL != ""
+	useCustomTitle := customTitle != ""
+	useCustomDescription := customDescription != ""
+
+	writeItem.CustomUrl = &customURL
+	writeItem.CustomTitle = &customTitle
+	writeItem.CustomDescription = &customDescription
+	writeItem.UseCustomUrl = &useCustomURL
+	writeItem.UseCustomTitle = &useCustomTitle
+	writeItem.UseCustomDescription = &useCustomDescription
+
+	return writeItem
+}
+
+func flattenBoardItem(item apiclient.BoardItem) map[string]interface{} {
+	flattened := map[string]interface{}{
+		"id":                  stringValue(item.Id),
+		"dashboard_id":        stringValue(item.DashboardId),
+		"look_id":             stringValue(item.LookId),
+		"lookml_dashboard_id": stringValue(item.LookmlDashboardId),
+		"url":                 "",
+		"title":               "",
+		"description":         "",
+	}
+	if boolValue(item.UseCustomUrl) {
+		flattened["url"] = stringValue(item.CustomUrl)
+	}
+	if boolValue(item.UseCustomTitle) {
+		flattened["title"] = stringValue(item.CustomTitle)
+	}
+	if boolValue(item.UseCustomDescription) {
+		flattened["description"] = stringValue(item.CustomDescription)
+	}
+
+	return flattened
+}
+
+func flattenBoardSections(sections []apiclient.BoardSection) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(sections))
+	for _, section := range sections {
+		items := []map[string]interface{}{}
+		for _, item := range orderBoardItems(section.BoardItems, section.ItemOrder) {
+			items = append(items, flattenBoardItem(item))
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":          stringValue(section.Id),
+			"title":       stringValue(section.Title),
+			"description": stringValue(section.Description),
+			"item":        items,
+		})
+	}
+
+	return result
+}
+
+// orderBoardSections sorts sections by the section order of the board, sections missing from it last
+func orderBoardSections(sections *[]apiclient.BoardSection, order *[]string) []apiclient.BoardSection {
+	if sections == nil {
+		return nil
+	}
+
+	var result []apiclient.BoardSection
+	for _, section := range *sections {
+		if section.DeletedAt == nil {
+			result = append(result, section)
+		}
+	}
+
+	positions := orderPositions(order)
+	sort.SliceStable(result, func(i, j int) bool {
+		return positions(stringValue(result[i].Id)) < positions(stringValue(result[j].Id))
+	})
+
+	return result
+}
+
+// orderBoardItems sorts items by the item order of their section, items missing from it last
+func orderBoardItems(items *[]apiclient.BoardItem, order *[]string) []apiclient.BoardItem {
+	if items == nil {
+		return nil
+	}
+
+	result := append([]apiclient.BoardItem{}, *items...)
+	positions := orderPositions(order)
+	sort.SliceStable(result, func(i, j int) bool {
+		return positions(stringValue(result[i].Id)) < positions(stringValue(result[j].Id))
+	})
+
+	return result
+}
+
+func orderPositions(order *[]string) func(id string) int {
+	positions := map[string]int{}
+	if order != nil {
+		for i, id := range *order {
+			positions[id] = i
+		}
+	}
+
+	return func(id string) int {
+		if position, ok := positions[id]; ok {
+			return position
+		}
+		return len(positions)
+	}
+}
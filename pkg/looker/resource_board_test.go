@@ -0,0 +1,264 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_Board(t *testing.T) {
+	title := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: boardConfig(title, `
+				section {
+					title = "Revenue"
+					item {
+						dashboard_id = looker_dashboard.test.id
+					}
+					item {
+						url   = "https://example.com/runbook"
+						title = "Runbook"
+					}
+				}
+				section {
+					title = "Archive"
+				}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_board.test", "title", title),
+					resource.TestCheckResourceAttr("looker_board.test", "section.#", "2"),
+					resource.TestCheckResourceAttr("looker_board.test", "section.0.item.#", "2"),
+					resource.TestCheckResourceAttrPair("looker_board.test", "section.0.item.0.dashboard_id", "looker_dashboard.test", "id"),
+					resource.TestCheckResourceAttr("looker_board.test", "section.0.item.1.url", "https://example.com/runbook"),
+					resource.TestCheckResourceAttr("looker_board.test", "section.1.item.#", "0"),
+				),
+			},
+			{
+				Config: boardConfig(title, `
+				section {
+					title = "Revenue"
+					item {
+						url   = "https://example.com/runbook"
+						title = "Runbook"
+					}
+					item {
+						dashboard_id = looker_dashboard.test.id
+						title        = "Revenue overview"
+					}
+				}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_board.test", "section.#", "1"),
+					resource.TestCheckResourceAttr("looker_board.test", "section.0.item.0.url", "https://example.com/runbook"),
+					resource.TestCheckResourceAttrPair("looker_board.test", "section.0.item.1.dashboard_id", "looker_dashboard.test", "id"),
+					resource.TestCheckResourceAttr("looker_board.test", "section.0.item.1.title", "Revenue overview"),
+				),
+			},
+			{
+				ResourceName:      "looker_board.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccCheckBoardDestroy,
+	})
+}
+
+func testAccCheckBoardDestroy(s *terraform.State) error {
+	client := apiclient.NewLookerSDK(testAccProvider.Meta().(*rtl.AuthSession))
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_board" {
+			continue
+		}
+
+		board, err := client.Board(rs.Primary.ID, "deleted_at", nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				continue // successfully destroyed
+			}
+			return err
+		}
+		if board.DeletedAt == nil {
+			return fmt.Errorf("board still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func boardConfig(title, sections string) string {
+	return fmt.Sprintf(`
+	data "looker_folder" "shared" {
+		path = "Shared"
+	}
+	resource "looker_dashboard" "test" {
+		title     = "%[1]s"
+		folder_id = data.looker_folder.shared.id
+	}
+	resource "looker_board" "test" {
+		title = "%[1]s"
+		%[2]s
+	}
+	`, title, sections)
+}
+
+func newBoardItem(id, dashboardID, customURL string) apiclient.BoardItem {
+	item := apiclient.BoardItem{Id: &id}
+	if dashboardID != "" {
+		item.DashboardId = &dashboardID
+	}
+	if customURL != "" {
+		useCustomURL := true
+		item.CustomUrl = &customURL
+		item.UseCustomUrl = &useCustomURL
+	}
+	return item
+}
+
+func TestMatchBoardItems(t *testing.T) {
+	tests := map[string]struct {
+		current     []apiclient.BoardItem
+		desired     []map[string]interface{}
+		wantMatched []string
+		wantDeletes []string
+	}{
+		"create": {
+			current:     nil,
+			desired:     []map[string]interface{}{{"dashboard_id": "1"}},
+			wantMatched: []string{""},
+			wantDeletes: nil,
+		},
+		"reorder": {
+			current:     []apiclient.BoardItem{newBoardItem("10", "1", ""), newBoardItem("11", "", "https://example.com")},
+			desired:     []map[string]interface{}{{"url": "https://example.com", "title": "Example"}, {"dashboard_id": "1"}},
+			wantMatched: []string{"11", "10"},
+			wantDeletes: nil,
+		},
+		"duplicates and removals": {
+			current:     []apiclient.BoardItem{newBoardItem("10", "1", ""), newBoardItem("11", "1", ""), newBoardItem("12", "2", "")},
+			desired:     []map[string]interface{}{{"dashboard_id": "1"}, {"dashboard_id": "3"}},
+			wantMatched: []string{"10", ""},
+			wantDeletes: []string{"11", "12"},
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			matched, deletes := matchBoardItems(tt.current, tt.desired)
+			a.Equal(tt.wantMatched, matched)
+			a.Equal(tt.wantDeletes, deletes)
+		})
+	}
+}
+
+func TestValidateBoardSections(t *testing.T) {
+	newItem := func(dashboardID, lookID, url, title string) interface{} {
+		return map[string]interface{}{
+			"dashboard_id":        dashboardID,
+			"look_id":             lookID,
+			"lookml_dashboard_id": "",
+			"url":                 url,
+			"title":               title,
+			"description":         "",
+		}
+	}
+	newSections := func(items ...interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{"title": "", "description": "", "item": items}}
+	}
+
+	tests := map[string]struct {
+		sections    []interface{}
+		unknownKeys map[string]bool
+		wantErr     bool
+	}{
+		"valid items": {
+			sections: newSections(newItem("1", "", "", ""), newItem("", "", "https://example.com", "Example")),
+			wantErr:  false,
+		},
+		"no content": {
+			sections: newSections(newItem("", "", "", "Nothing")),
+			wantErr:  true,
+		},
+		"url without title": {
+			sections: newSections(newItem("", "", "https://example.com", "")),
+			wantErr:  true,
+		},
+		"several contents": {
+			sections: newSections(newItem("1", "2", "", "")),
+			wantErr:  true,
+		},
+		"content not known yet": {
+			sections:    newSections(newItem("", "", "", "")),
+			unknownKeys: map[string]bool{"section.0.item.0.dashboard_id": true},
+			wantErr:     false,
+		},
+		"url title not known yet": {
+			sections:    newSections(newItem("", "", "https://example.com", "")),
+			unknownKeys: map[string]bool{"section.0.item.0.title": true},
+			wantErr:     false,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			err := validateBoardSections(tt.sections, func(key string) bool { return !tt.unknownKeys[key] })
+			if tt.wantErr {
+				a.Error(err)
+			} else {
+				a.NoError(err)
+			}
+		})
+	}
+}
+
+func TestOrderBoardItems(t *testing.T) {
+	items := []apiclient.BoardItem{
+		newBoardItem("10", "1", ""),
+		newBoardItem("11", "2", ""),
+		newBoardItem("12", "3", ""),
+	}
+
+	tests := map[string]struct {
+		order   *[]string
+		wantRes []string
+	}{
+		"no order": {
+			order:   nil,
+			wantRes: []string{"10", "11", "12"},
+		},
+		"item order": {
+			order:   &[]string{"12", "10", "11"},
+			wantRes: []string{"12", "10", "11"},
+		},
+		"items missing from the order last": {
+			order:   &[]string{"11"},
+			wantRes: []string{"11", "10", "12"},
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			var ids []string
+			for _, item := range orderBoardItems(&items, tt.order) {
+				ids = append(ids, *item.Id)
+			}
+			a.Equal(tt.wantRes, ids)
+		})
+	}
+}
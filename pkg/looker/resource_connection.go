@@ -21,6 +21,7 @@ func resourceConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceConnectionImport,
 		},
+		CustomizeDiff: resourceConnectionCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -75,12 +76,14 @@ func resourceConnection() *schema.Resource {
 				Required: true,
 			},
 			"db_timezone": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:        schema.TypeString,
+				Description: "Time zone of the database. Must be one of the values of the looker_timezones data source.",
+				Optional:    true,
 			},
 			"query_timezone": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:        schema.TypeString,
+				Description: "Time zone queries are converted to. Must be one of the values of the looker_timezones data source.",
+				Optional:    true,
 			},
 			"schema": {
 				Type:     schema.TypeString,
@@ -314,6 +317,46 @@ func resourceConnectionImport(ctx context.Context, d *schema.ResourceData, m int
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceConnectionCustomizeDiff rejects timezones the instance does not support at plan time
+func resourceConnectionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	var timezones []apiclient.Timezone
+	for _, key := range []string{"db_timezone", "query_timezone"} {
+		if !d.HasChange(key) || !d.NewValueKnown(key) {
+			continue
+		}
+		timezone := d.Get(key).(string)
+		if timezone == "" {
+			continue
+		}
+
+		if timezones == nil {
+			session := m.(*rtl.AuthSession)
+			client := apiclient.NewLookerSDK(session)
+
+			var err error
+			timezones, err = client.AllTimezones(nil)
+			if err != nil {
+				return fmt.Errorf("failed to read supported timezones: %v", err)
+			}
+		}
+
+		if err := validateTimezone(timezone, timezones); err != nil {
+			return fmt.Errorf("%s: %v", key, err)
+		}
+	}
+
+	return nil
+}
+
+func validateTimezone(timezone string, timezones []apiclient.Timezone) error {
+	for _, t := range timezones {
+		if stringValue(t.Value) == timezone {
+			return nil
+		}
+	}
+	return fmt.Errorf("timezone %q is not supported by the Looker instance, see the looker_timezones data source for valid values", timezone)
+}
+
 func expandWriteDBConnection(d *schema.ResourceData) (*apiclient.WriteDBConnection, error) {
 	// required values
 	name := d.Get("name").(string)
@@ -2,6 +2,7 @@ package looker
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAcc_Connection(t *testing.T) {
@@ -36,6 +38,58 @@ func TestAcc_Connection(t *testing.T) {
 	})
 }
 
+func TestAcc_ConnectionInvalidTimezone(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      connectionConfigWithTimezone(name, "Mars/Olympus_Mons"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`timezone "Mars/Olympus_Mons" is not supported`),
+			},
+		},
+	})
+}
+
+func TestValidateTimezone(t *testing.T) {
+	tokyo := "Asia/Tokyo"
+	utc := "UTC"
+	timezones := []apiclient.Timezone{{Value: &tokyo}, {Value: &utc}}
+
+	tests := map[string]struct {
+		timezone string
+		wantErr  bool
+	}{
+		"supported": {
+			timezone: "Asia/Tokyo",
+			wantErr:  false,
+		},
+		"unsupported": {
+			timezone: "Asia/Tokio",
+			wantErr:  true,
+		},
+		"case sensitive": {
+			timezone: "utc",
+			wantErr:  true,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			err := validateTimezone(tt.timezone, timezones)
+			if tt.wantErr {
+				a.Error(err)
+			} else {
+				a.NoError(err)
+			}
+		})
+	}
+}
+
 func testAccCheckConnectionExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -92,3 +146,19 @@ func connectionConfig(name string) string {
 	}
 	`, name)
 }
+
+func connectionConfigWithTimezone(name, timezone string) string {
+	return fmt.Sprintf(`
+	resource "looker_connection" "test" {
+		name           = "%s"
+		host           = "test_project"
+		username       = "test@testproject.iam.gserviceaccount.com"
+		certificate    = filebase64("testdata/gcp-sa.json")
+		file_type      = ".json"
+		database       = "test_dataset"
+		tmp_db_name    = "tmp_test_dataset"
+		dialect_name   = "bigquery_standard_sql"
+		query_timezone = "%s"
+	}
+	`, name, timezone)
+}
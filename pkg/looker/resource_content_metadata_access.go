@@ -0,0 +1,331 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceContentMetadataAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceContentMetadataAccessCreate,
+		ReadContext:   resourceContentMetadataAccessRead,
+		UpdateContext: resourceContentMetadataAccessUpdate,
+		DeleteContext: resourceContentMetadataAccessDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceContentMetadataAccessCustomizeDiff,
+		Description:   "Manages the access entries of a folder authoritatively: entries not in the configuration are removed. Destroying the resource makes the folder inherit its access from the parent folder again.",
+		Schema: map[string]*schema.Schema{
+			"content_metadata_id": {
+				Type:        schema.TypeString,
+				Description: "Content metadata ID of the folder, e.g. `looker_folder.content_metadata_id`",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"inherits": {
+				Type:        schema.TypeBool,
+				Description: "Whether the folder inherits its access from the parent folder. Access entries can only be set when false.",
+				Required:    true,
+			},
+			"group_access": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"permission_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{string(apiclient.PermissionType_View), string(apiclient.PermissionType_Edit)}, false),
+						},
+					},
+				},
+			},
+			"user_access": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"permission_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{string(apiclient.PermissionType_View), string(apiclient.PermissionType_Edit)}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceContentMetadataAccessCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if err := validateUniqueAccessPrincipals(d.Get("group_access").(*schema.Set).List(), "group_id"); err != nil {
+		return fmt.Errorf("group_access: %v", err)
+	}
+	if err := validateUniqueAccessPrincipals(d.Get("user_access").(*schema.Set).List(), "user_id"); err != nil {
+		return fmt.Errorf("user_access: %v", err)
+	}
+
+	if !d.NewValueKnown("inherits") || !d.Get("inherits").(bool) {
+		return nil
+	}
+	if d.Get("group_access").(*schema.Set).Len() > 0 || d.Get("user_access").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("group_access and user_access can only be set when inherits is false")
+	}
+	return nil
+}
+
+// validateUniqueAccessPrincipals rejects a group or user listed with several permission types,
+// as only one access entry can exist per principal. IDs that are not known yet are skipped.
+func validateUniqueAccessPrincipals(entries []interface{}, idKey string) error {
+	seen := map[string]bool{}
+	for _, raw := range entries {
+		entry, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id := entry[idKey].(string)
+		if id == "" {
+			continue
+		}
+		if seen[id] {
+			return fmt.Errorf("%s %s is listed more than once", idKey, id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func resourceContentMetadataAccessCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	contentMetadataID := d.Get("content_metadata_id").(string)
+
+	if err := applyContentMetadataAccess(m, contentMetadataID, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(contentMetadataID)
+
+	return resourceContentMetadataAccessRead(ctx, d, m)
+}
+
+func resourceContentMetadataAccessRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	contentMetadataID := d.Id()
+
+	contentMetadata, err := client.ContentMetadata(contentMetadataID, "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	inherits := boolValue(contentMetadata.Inherits)
+
+	// inherited entries belong to the parent folder
+	var accesses []apiclient.ContentMetaGroupUser
+	if !inherits {
+		accesses, err = client.AllContentMetadataAccesses(contentMetadataID, "", nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err = d.Set("content_metadata_id", contentMetadataID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("inherits", inherits); err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupAccess, userAccess := flattenContentMetadataAccesses(accesses)
+	if err = d.Set("group_access", groupAccess); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_access", userAccess); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceContentMetadataAccessUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := applyContentMetadataAccess(m, d.Id(), d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceContentMetadataAccessRead(ctx, d, m)
+}
+
+func resourceContentMetadataAccessDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	inherits := true
+	_, err := client.UpdateContentMetadata(d.Id(), apiclient.WriteContentMeta{Inherits: &inherits}, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil // the folder has been deleted
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// applyContentMetadataAccess sets the inherit flag first, since turning it off copies the
+// entries of the parent folder, and then converges the entries to the configuration
+func applyContentMetadataAccess(m interface{}, contentMetadataID string, d *schema.ResourceData) error {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	inherits := d.Get("inherits").(bool)
+	_, err := client.UpdateContentMetadata(contentMetadataID, apiclient.WriteContentMeta{Inherits: &inherits}, nil)
+	if err != nil {
+		return err
+	}
+	if inherits {
+		return nil
+	}
+
+	current, err := client.AllContentMetadataAccesses(contentMetadataID, "", nil)
+	if err != nil {
+		return err
+	}
+
+	desired := expandContentMetadataAccesses(contentMetadataID, d.Get("group_access").(*schema.Set), d.Get("user_access").(*schema.Set))
+	creates, updates, deletes := diffContentMetadataAccesses(current, desired)
+
+	// grant before revoking so the folder never ends up without any editor
+	for _, access := range creates {
+		if _, err = client.CreateContentMetadataAccess(access, false, nil); err != nil {
+			return err
+		}
+	}
+	for _, access := range updates {
+		if _, err = client.UpdateContentMetadataAccess(*access.Id, access, nil); err != nil {
+			return err
+		}
+	}
+	for _, access := range deletes {
+		if _, err = client.DeleteContentMetadataAccess(*access.Id, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func contentMetadataAccessKey(access apiclient.ContentMetaGroupUser) string {
+	if access.GroupId != nil {
+		return "group:" + *access.GroupId
+	}
+	return "user:" + stringValue(access.UserId)
+}
+
+func expandContentMetadataAccesses(contentMetadataID string, groupAccess, userAccess *schema.Set) []apiclient.ContentMetaGroupUser {
+	var result []apiclient.ContentMetaGroupUser
+	for _, raw := range groupAccess.List() {
+		access := raw.(map[string]interface{})
+		groupID := access["group_id"].(string)
+		permissionType := apiclient.PermissionType(access["permission_type"].(string))
+		result = append(result, apiclient.ContentMetaGroupUser{
+			ContentMetadataId: &contentMetadataID,
+			GroupId:           &groupID,
+			PermissionType:    &permissionType,
+		})
+	}
+	for _, raw := range userAccess.List() {
+		access := raw.(map[string]interface{})
+		userID := access["user_id"].(string)
+		permissionType := apiclient.PermissionType(access["permission_type"].(string))
+		result = append(result, apiclient.ContentMetaGroupUser{
+			ContentMetadataId: &contentMetadataID,
+			UserId:            &userID,
+			PermissionType:    &permissionType,
+		})
+	}
+	return result
+}
+
+// diffContentMetadataAccesses returns the entries to create, to update and to delete
+// so that the current entries match the desired ones
+func diffContentMetadataAccesses(current, desired []apiclient.ContentMetaGroupUser) (creates, updates, deletes []apiclient.ContentMetaGroupUser) {
+	currentByKey := make(map[string]apiclient.ContentMetaGroupUser, len(current))
+	for _, access := range current {
+		currentByKey[contentMetadataAccessKey(access)] = access
+	}
+
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, access := range desired {
+		key := contentMetadataAccessKey(access)
+		desiredKeys[key] = true
+
+		existing, ok := currentByKey[key]
+		if !ok {
+			creates = append(creates, access)
+			continue
+		}
+		if existing.PermissionType == nil || *existing.PermissionType != *access.PermissionType {
+			access.Id = existing.Id
+			updates = append(updates, access)
+		}
+	}
+
+	for _, access := range current {
+		if !desiredKeys[contentMetadataAccessKey(access)] {
+			deletes = append(deletes, access)
+		}
+	}
+
+	sortAccesses := func(accesses []apiclient.ContentMetaGroupUser) {
+		sort.SliceStable(accesses, func(i, j int) bool {
+			return contentMetadataAccessKey(accesses[i]) < contentMetadataAccessKey(accesses[j])
+		})
+	}
+	sortAccesses(creates)
+	sortAccesses(updates)
+	sortAccesses(deletes)
+
+	return creates, updates, deletes
+}
+
+func flattenContentMetadataAccesses(accesses []apiclient.ContentMetaGroupUser) (groupAccess, userAccess []map[string]interface{}) {
+	for _, access := range accesses {
+		var permissionType string
+		if access.PermissionType != nil {
+			permissionType = string(*access.PermissionType)
+		}
+		if access.GroupId != nil {
+			groupAccess = append(groupAccess, map[string]interface{}{
+				"group_id":        *access.GroupId,
+				"permission_type": permissionType,
+			})
+		} else if access.UserId != nil {
+			userAccess = append(userAccess, map[string]interface{}{
+				"user_id":         *access.UserId,
+				"permission_type": permissionType,
+			})
+		}
+	}
+	return groupAccess, userAccess
+}
@@ -0,0 +1,180 @@
+package looker
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_ContentMetadataAccess(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: contentMetadataAccessConfig(name, "view"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_content_metadata_access.test", "inherits", "false"),
+					resource.TestCheckResourceAttr("looker_content_metadata_access.test", "group_access.#", "1"),
+					resource.TestCheckResourceAttr("looker_content_metadata_access.test", "group_access.0.permission_type", "view"),
+				),
+			},
+			{
+				Config: contentMetadataAccessConfig(name, "edit"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_content_metadata_access.test", "group_access.0.permission_type", "edit"),
+				),
+			},
+			{
+				ResourceName:      "looker_content_metadata_access.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+				data "looker_folder" "shared" {
+					path = "Shared"
+				}
+				resource "looker_folder" "test" {
+					name      = "%s"
+					parent_id = data.looker_folder.shared.id
+				}
+				resource "looker_content_metadata_access" "test" {
+					content_metadata_id = looker_folder.test.content_metadata_id
+					inherits            = true
+					user_access {
+						user_id         = "1"
+						permission_type = "view"
+					}
+				}
+				`, name),
+				ExpectError: regexp.MustCompile("can only be set when inherits is false"),
+			},
+		},
+	})
+}
+
+func contentMetadataAccessConfig(name, permissionType string) string {
+	return fmt.Sprintf(`
+	data "looker_folder" "shared" {
+		path = "Shared"
+	}
+	resource "looker_folder" "test" {
+		name      = "%[1]s"
+		parent_id = data.looker_folder.shared.id
+	}
+	resource "looker_group" "test" {
+		name = "%[1]s"
+	}
+	resource "looker_content_metadata_access" "test" {
+		content_metadata_id = looker_folder.test.content_metadata_id
+		inherits            = false
+		group_access {
+			group_id        = looker_group.test.id
+			permission_type = "%[2]s"
+		}
+	}
+	`, name, permissionType)
+}
+
+func TestValidateUniqueAccessPrincipals(t *testing.T) {
+	newEntry := func(groupID, permissionType string) interface{} {
+		return map[string]interface{}{"group_id": groupID, "permission_type": permissionType}
+	}
+
+	tests := map[string]struct {
+		entries []interface{}
+		wantErr bool
+	}{
+		"distinct groups": {
+			entries: []interface{}{newEntry("1", "view"), newEntry("2", "edit")},
+			wantErr: false,
+		},
+		"group with several permission types": {
+			entries: []interface{}{newEntry("1", "view"), newEntry("1", "edit")},
+			wantErr: true,
+		},
+		"groups not known yet": {
+			entries: []interface{}{newEntry("", "view"), newEntry("", "edit")},
+			wantErr: false,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			err := validateUniqueAccessPrincipals(tt.entries, "group_id")
+			if tt.wantErr {
+				a.Error(err)
+			} else {
+				a.NoError(err)
+			}
+		})
+	}
+}
+
+func TestDiffContentMetadataAccesses(t *testing.T) {
+	newAccess := func(id, groupID, userID string, permissionType apiclient.PermissionType) apiclient.ContentMetaGroupUser {
+		access := apiclient.ContentMetaGroupUser{PermissionType: &permissionType}
+		if id != "" {
+			access.Id = &id
+		}
+		if groupID != "" {
+			access.GroupId = &groupID
+		}
+		if userID != "" {
+			access.UserId = &userID
+		}
+		return access
+	}
+
+	tests := map[string]struct {
+		current     []apiclient.ContentMetaGroupUser
+		desired     []apiclient.ContentMetaGroupUser
+		wantCreates []apiclient.ContentMetaGroupUser
+		wantUpdates []apiclient.ContentMetaGroupUser
+		wantDeletes []apiclient.ContentMetaGroupUser
+	}{
+		"no change": {
+			current: []apiclient.ContentMetaGroupUser{newAccess("1", "10", "", apiclient.PermissionType_View)},
+			desired: []apiclient.ContentMetaGroupUser{newAccess("", "10", "", apiclient.PermissionType_View)},
+		},
+		"create, update and delete": {
+			current: []apiclient.ContentMetaGroupUser{
+				newAccess("1", "10", "", apiclient.PermissionType_View),
+				newAccess("2", "", "20", apiclient.PermissionType_Edit),
+			},
+			desired: []apiclient.ContentMetaGroupUser{
+				newAccess("", "10", "", apiclient.PermissionType_Edit),
+				newAccess("", "11", "", apiclient.PermissionType_View),
+			},
+			wantCreates: []apiclient.ContentMetaGroupUser{newAccess("", "11", "", apiclient.PermissionType_View)},
+			wantUpdates: []apiclient.ContentMetaGroupUser{newAccess("1", "10", "", apiclient.PermissionType_Edit)},
+			wantDeletes: []apiclient.ContentMetaGroupUser{newAccess("2", "", "20", apiclient.PermissionType_Edit)},
+		},
+		"same id for group and user": {
+			current:     []apiclient.ContentMetaGroupUser{newAccess("1", "10", "", apiclient.PermissionType_View)},
+			desired:     []apiclient.ContentMetaGroupUser{newAccess("", "", "10", apiclient.PermissionType_View)},
+			wantCreates: []apiclient.ContentMetaGroupUser{newAccess("", "", "10", apiclient.PermissionType_View)},
+			wantDeletes: []apiclient.ContentMetaGroupUser{newAccess("1", "10", "", apiclient.PermissionType_View)},
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			creates, updates, deletes := diffContentMetadataAccesses(tt.current, tt.desired)
+			a.Equal(tt.wantCreates, creates)
+			a.Equal(tt.wantUpdates, updates)
+			a.Equal(tt.wantDeletes, deletes)
+		})
+	}
+}
@@ -0,0 +1,1037 @@
+package looker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// The generated WriteDashboardElement drops result_maker.filterables, which is how a
+// tile listens to dashboard filters, so elements are written with these wrappers.
+type dashboardElementWrite struct {
+	apiclient.WriteDashboardElement
+	ResultMaker *dashboardElementResultMakerWrite `json:"result_maker,omitempty"`
+}
+
+type dashboardElementResultMakerWrite struct {
+	Filterables *[]apiclient.ResultMakerFilterables `json:"filterables,omitempty"`
+}
+
+func resourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDashboardCreate,
+		ReadContext:   resourceDashboardRead,
+		UpdateContext: resourceDashboardUpdate,
+		DeleteContext: resourceDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceDashboardCustomizeDiff,
+		Description:   "Manages a user-defined dashboard with its filters, tiles and layout. Filters and tiles are matched by position, so reordering blocks updates the existing ones in place.",
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"folder_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"refresh_interval": {
+				Type:        schema.TypeString,
+				Description: "Refresh interval as a duration phrase, e.g. `1 hour`",
+				Optional:    true,
+			},
+			"query_timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"field_filter", "date_filter", "number_filter", "string_filter"}, false),
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"model": {
+							Type:        schema.TypeString,
+							Description: "Model of the field, required for `field_filter`",
+							Optional:    true,
+						},
+						"explore": {
+							Type:        schema.TypeString,
+							Description: "Explore of the field, required for `field_filter`",
+							Optional:    true,
+						},
+						"dimension": {
+							Type:        schema.TypeString,
+							Description: "Field to filter on, required for `field_filter`",
+							Optional:    true,
+						},
+						"allow_multiple_values": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"listens_to_filters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ui_config": {
+							Type:             schema.TypeString,
+							Description:      "JSON object configuring the filter control",
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentJSONDiff,
+						},
+					},
+				},
+			},
+			"element": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "`vis` for query and look tiles, `text` for text tiles",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"vis", "text"}, false),
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"title_hidden": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"title_text": {
+							Type:        schema.TypeString,
+							Description: "Title of a text tile",
+							Optional:    true,
+						},
+						"subtitle_text": {
+							Type:        schema.TypeString,
+							Description: "Subtitle of a text tile",
+							Optional:    true,
+						},
+						"body_text": {
+							Type:        schema.TypeString,
+							Description: "Markdown body of a text tile",
+							Optional:    true,
+						},
+						"note_text": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"look_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"query": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: querySchema(),
+							},
+						},
+						"listen": {
+							Type:        schema.TypeMap,
+							Description: "Dashboard filters the tile listens to, mapping the filter name to the field of the query it filters. Only query tiles can listen to filters.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"row": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"column": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"width": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"height": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// querySchema describes a query, which is immutable in Looker: changing it creates a new query
+func querySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"model": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"view": {
+			Type:        schema.TypeString,
+			Description: "Name of the explore to query",
+			Required:    true,
+		},
+		"fields": {
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"pivots": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"filters": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"filter_expression": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"sorts": {
+			Type:        schema.TypeList,
+			Description: "Looker picks a default sort when empty",
+			Optional:    true,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"limit": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"total": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"dynamic_fields": {
+			Type:         schema.TypeString,
+			Description:  "JSON array of custom fields and table calculations",
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+		"vis_config": {
+			Type:             schema.TypeString,
+			Description:      "JSON object of visualization settings. Settings Looker adds with default values are ignored unless they are configured.",
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: suppressEquivalentJSONDiff,
+		},
+		"query_timezone": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func resourceDashboardCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return validateDashboardElements(d.Get("element").([]interface{}))
+}
+
+// validateDashboardElements rejects listeners on tiles without a query, Looker ignores them
+func validateDashboardElements(elements []interface{}) error {
+	for i, raw := range elements {
+		element, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		listen, _ := element["listen"].(map[string]interface{})
+		queries, _ := element["query"].([]interface{})
+		if len(listen) > 0 && len(queries) == 0 {
+			return fmt.Errorf("element.%d: listen can only be set for tiles with a query", i)
+		}
+	}
+	return nil
+}
+
+func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	dashboard, err := client.CreateDashboard(expandDashboard(d), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*dashboard.Id)
+
+	if err = syncDashboardContent(session, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDashboardRead(ctx, d, m)
+}
+
+func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	dashboard, err := client.Dashboard(d.Id(), "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if boolValue(dashboard.Deleted) {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("title", dashboard.Title); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", dashboard.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("folder_id", dashboard.FolderId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("refresh_interval", dashboard.RefreshInterval); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("query_timezone", dashboard.QueryTimezone); err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters, err := flattenDashboardFilters(dashboard.DashboardFilters, appliedDashboardFilterUIConfigs(d.Get("filter").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("filter", filters); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var currentElements []apiclient.DashboardElement
+	if dashboard.DashboardElements != nil {
+		currentElements = orderDashboardElements(*dashboard.DashboardElements, dashboardElementIDs(d.Get("element").([]interface{})))
+	}
+	elements, err := flattenDashboardElements(currentElements, activeLayoutComponents(dashboard.DashboardLayouts), appliedDashboardElementVisConfigs(d.Get("element").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("element", elements); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	if d.HasChanges("title", "description", "folder_id", "refresh_interval", "query_timezone") {
+		if _, err := client.UpdateDashboard(d.Id(), expandDashboard(d), nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("filter", "element") {
+		if err := syncDashboardContent(session, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDashboardRead(ctx, d, m)
+}
+
+func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	_, err := client.DeleteDashboard(d.Id(), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil // already deleted outside of terraform
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandDashboard(d *schema.ResourceData) apiclient.WriteDashboard {
+	title := d.Get("title").(string)
+	description := d.Get("description").(string)
+	folderID := d.Get("folder_id").(string)
+	refreshInterval := d.Get("refresh_interval").(string)
+	queryTimezone := d.Get("query_timezone").(string)
+
+	return apiclient.WriteDashboard{
+		Title:           &title,
+		Description:     &description,
+		FolderId:        &folderID,
+		RefreshInterval: &refreshInterval,
+		QueryTimezone:   &queryTimezone,
+	}
+}
+
+// syncDashboardContent converges the filters, elements and layout of the dashboard to the
+// configuration. Existing filters and elements are matched to the blocks by position.
+func syncDashboardContent(session *rtl.AuthSession, d *schema.ResourceData) error {
+	client := apiclient.NewLookerSDK(session)
+	dashboardID := d.Id()
+
+	currentFilters, err := client.DashboardDashboardFilters(dashboardID, "", nil)
+	if err != nil {
+		return err
+	}
+	sortDashboardFilters(currentFilters)
+
+	currentElements, err := client.DashboardDashboardElements(dashboardID, "", nil)
+	if err != nil {
+		return err
+	}
+
+	// filters go first so that elements can listen to new ones
+	filters := d.Get("filter").([]interface{})
+	for i, raw := range filters {
+		filter, err := expandDashboardFilter(raw.(map[string]interface{}), i)
+		if err != nil {
+			return err
+		}
+		if i < len(currentFilters) {
+			if _, err = client.UpdateDashboardFilter(*currentFilters[i].Id, filter, "", nil); err != nil {
+				return err
+			}
+			continue
+		}
+		_, err = client.CreateDashboardFilter(apiclient.WriteCreateDashboardFilter{
+			DashboardId:         dashboardID,
+			Name:                *filter.Name,
+			Title:               *filter.Title,
+			Type:                *filter.Type,
+			DefaultValue:        filter.DefaultValue,
+			Model:               filter.Model,
+			Explore:             filter.Explore,
+			Dimension:           filter.Dimension,
+			Row:                 filter.Row,
+			ListensToFilters:    filter.ListensToFilters,
+			AllowMultipleValues: filter.AllowMultipleValues,
+			Required:            filter.Required,
+			UiConfig:            filter.UiConfig,
+		}, "", nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	previous, _ := d.GetChange("element")
+	currentElements = orderDashboardElements(currentElements, dashboardElementIDs(previous.([]interface{})))
+
+	elements := d.Get("element").([]interface{})
+	elementIDs := make([]string, len(elements))
+	replaced := map[int]bool{}
+	for i, raw := range elements {
+		var currentID string
+		if i < len(currentElements) {
+			currentID = *currentElements[i].Id
+			elementIDs[i] = currentID
+			if !d.HasChange(fmt.Sprintf("element.%d", i)) {
+				continue
+			}
+			// an element cannot switch between text, look and query tiles
+			if dashboardElementNeedsReplace(d, i) {
+				if _, err = client.DeleteDashboardElement(currentID, nil); err != nil {
+					return err
+				}
+				currentID = ""
+			}
+		}
+		if currentID == "" {
+			replaced[i] = true
+		}
+
+		element, err := expandDashboardElement(client, dashboardID, raw.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+
+		var result apiclient.DashboardElement
+		if currentID != "" {
+			err = session.Do(&result, "PATCH", "/4.0", fmt.Sprintf("/dashboard_elements/%v", url.PathEscape(currentID)), nil, element, nil)
+		} else {
+			err = session.Do(&result, "POST", "/4.0", "/dashboard_elements", nil, element, nil)
+		}
+		if err != nil {
+			return err
+		}
+		elementIDs[i] = *result.Id
+	}
+
+	for _, element := range currentElements[minInt(len(elements), len(currentElements)):] {
+		if _, err = client.DeleteDashboardElement(*element.Id, nil); err != nil {
+			return err
+		}
+	}
+	for _, filter := range currentFilters[minInt(len(filters), len(currentFilters)):] {
+		if _, err = client.DeleteDashboardFilter(*filter.Id, nil); err != nil {
+			return err
+		}
+	}
+
+	// record the element IDs so that the read keeps the elements in configuration order
+	for i, raw := range elements {
+		raw.(map[string]interface{})["id"] = elementIDs[i]
+	}
+	if err = d.Set("element", elements); err != nil {
+		return err
+	}
+
+	return syncDashboardLayout(client, d, elementIDs, replaced)
+}
+
+// syncDashboardLayout moves and resizes the tiles whose position changed in the configuration
+func syncDashboardLayout(client *apiclient.LookerSDK, d *schema.ResourceData, elementIDs []string, created map[int]bool) error {
+	layouts, err := client.DashboardDashboardLayouts(d.Id(), "", nil)
+	if err != nil {
+		return err
+	}
+	components := activeLayoutComponents(&layouts)
+
+	for i, elementID := range elementIDs {
+		prefix := fmt.Sprintf("element.%d.", i)
+		if created[i] {
+			// new tiles are placed by Looker unless a position or size is given
+			if !dashboardElementHasLayout(d, prefix) {
+				continue
+			}
+		} else if !d.HasChanges(prefix+"row", prefix+"column", prefix+"width", prefix+"height") {
+			continue
+		}
+
+		component, ok := components[elementID]
+		if !ok {
+			return fmt.Errorf("no layout component found for dashboard element %s", elementID)
+		}
+
+		row := int64(d.Get(prefix + "row").(int))
+		column := int64(d.Get(prefix + "column").(int))
+		body := apiclient.WriteDashboardLayoutComponent{
+			Row:    &row,
+			Column: &column,
+		}
+		if width := int64(d.Get(prefix + "width").(int)); width > 0 {
+			body.Width = &width
+		}
+		if height := int64(d.Get(prefix + "height").(int)); height > 0 {
+			body.Height = &height
+		}
+
+		if _, err = client.UpdateDashboardLayoutComponent(*component.Id, body, "", nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func dashboardElementHasLayout(d *schema.ResourceData, prefix string) bool {
+	for _, key := range []string{"row", "column", "width", "height"} {
+		if _, ok := d.GetOk(prefix + key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func sortDashboardFilters(filters []apiclient.DashboardFilter) {
+	sort.SliceStable(filters, func(i, j int) bool {
+		var rowI, rowJ int64
+		if filters[i].Row != nil {
+			rowI = *filters[i].Row
+		}
+		if filters[j].Row != nil {
+			rowJ = *filters[j].Row
+		}
+		if rowI != rowJ {
+			return rowI < rowJ
+		}
+		return lessID(stringValue(filters[i].Id), stringValue(filters[j].Id))
+	})
+}
+
+func dashboardElementNeedsReplace(d *schema.ResourceData, i int) bool {
+	prefix := fmt.Sprintf("element.%d.", i)
+	if d.HasChanges(prefix+"type", prefix+"look_id") {
+		return true
+	}
+	oldQuery, newQuery := d.GetChange(prefix + "query")
+	return len(oldQuery.([]interface{})) != len(newQuery.([]interface{}))
+}
+
+func dashboardElementIDs(elements []interface{}) []string {
+	ids := make([]string, 0, len(elements))
+	for _, raw := range elements {
+		if element, ok := raw.(map[string]interface{}); ok {
+			ids = append(ids, element["id"].(string))
+		}
+	}
+	return ids
+}
+
+// orderDashboardElements puts the elements in the order of ids, which is the order of the
+// configuration, followed by the unknown elements in creation order. Elements have no
+// position of their own.
+func orderDashboardElements(elements []apiclient.DashboardElement, ids []string) []apiclient.DashboardElement {
+	position := make(map[string]int, len(ids))
+	for i, id := range ids {
+		position[id] = i
+	}
+
+	ordered := make([]apiclient.DashboardElement, len(elements))
+	copy(ordered, elements)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		idI, idJ := stringValue(ordered[i].Id), stringValue(ordered[j].Id)
+		posI, knownI := position[idI]
+		posJ, knownJ := position[idJ]
+		switch {
+		case knownI && knownJ:
+			return posI < posJ
+		case knownI != knownJ:
+			return knownI
+		default:
+			return lessID(idI, idJ)
+		}
+	})
+	return ordered
+}
+
+// activeLayoutComponents returns the components of the active layout keyed by dashboard element ID
+func activeLayoutComponents(layouts *[]apiclient.DashboardLayout) map[string]apiclient.DashboardLayoutComponent {
+	components := map[string]apiclient.DashboardLayoutComponent{}
+	if layouts == nil {
+		return components
+	}
+	for _, layout := range *layouts {
+		if !boolValue(layout.Active) || layout.DashboardLayoutComponents == nil {
+			continue
+		}
+		for _, component := range *layout.DashboardLayoutComponents {
+			components[stringValue(component.DashboardElementId)] = component
+		}
+	}
+	return components
+}
+
+func expandDashboardFilter(raw map[string]interface{}, row int) (apiclient.WriteDashboardFilter, error) {
+	name := raw["name"].(string)
+	title := raw["title"].(string)
+	filterType := raw["type"].(string)
+	allowMultipleValues := raw["allow_multiple_values"].(bool)
+	required := raw["required"].(bool)
+	listensToFilters := expandStringList(raw["listens_to_filters"].([]interface{}))
+	filterRow := int64(row)
+
+	filter := apiclient.WriteDashboardFilter{
+		Name:                &name,
+		Title:               &title,
+		Type:                &filterType,
+		Row:                 &filterRow,
+		AllowMultipleValues: &allowMultipleValues,
+		Required:            &required,
+		ListensToFilters:    &listensToFilters,
+	}
+
+	if v := raw["default_value"].(string); v != "" {
+		filter.DefaultValue = &v
+	}
+	if v := raw["model"].(string); v != "" {
+		filter.Model = &v
+	}
+	if v := raw["explore"].(string); v != "" {
+		filter.Explore = &v
+	}
+	if v := raw["dimension"].(string); v != "" {
+		filter.Dimension = &v
+	}
+	if v := raw["ui_config"].(string); v != "" {
+		var uiConfig map[string]interface{}
+		if err := json.Unmarshal([]byte(v), &uiConfig); err != nil {
+			return filter, fmt.Errorf("filter %q: invalid ui_config: %v", name, err)
+		}
+		filter.UiConfig = &uiConfig
+	}
+
+	return filter, nil
+}
+
+// appliedDashboardFilterUIConfigs returns the applied ui_config of every filter keyed by filter name
+func appliedDashboardFilterUIConfigs(filters []interface{}) map[string]string {
+	applied := make(map[string]string, len(filters))
+	for _, raw := range filters {
+		if filter, ok := raw.(map[string]interface{}); ok {
+			applied[filter["name"].(string)] = filter["ui_config"].(string)
+		}
+	}
+	return applied
+}
+
+// appliedDashboardElementVisConfigs returns the applied vis_config of every query tile keyed by element ID
+func appliedDashboardElementVisConfigs(elements []interface{}) map[string]string {
+	applied := make(map[string]string, len(elements))
+	for _, raw := range elements {
+		element, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		queries := element["query"].([]interface{})
+		if len(queries) == 0 || queries[0] == nil {
+			continue
+		}
+		applied[element["id"].(string)] = queries[0].(map[string]interface{})["vis_config"].(string)
+	}
+	return applied
+}
+
+func flattenDashboardFilters(filters *[]apiclient.DashboardFilter, appliedUIConfigs map[string]string) ([]map[string]interface{}, error) {
+	if filters == nil {
+		return nil, nil
+	}
+
+	sorted := make([]apiclient.DashboardFilter, len(*filters))
+	copy(sorted, *filters)
+	sortDashboardFilters(sorted)
+
+	result := make([]map[string]interface{}, 0, len(sorted))
+	for _, filter := range sorted {
+		listensToFilters := []string{}
+		if filter.ListensToFilters != nil {
+			listensToFilters = *filter.ListensToFilters
+		}
+
+		var uiConfig string
+		if filter.UiConfig != nil {
+			var err error
+			uiConfig, err = marshalAppliedJSONKeys(*filter.UiConfig, appliedUIConfigs[stringValue(filter.Name)])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                    stringValue(filter.Id),
+			"name":                  stringValue(filter.Name),
+			"title":                 stringValue(filter.Title),
+			"type":                  stringValue(filter.Type),
+			"default_value":         stringValue(filter.DefaultValue),
+			"model":                 stringValue(filter.Model),
+			"explore":               stringValue(filter.Explore),
+			"dimension":             stringValue(filter.Dimension),
+			"allow_multiple_values": boolValue(filter.AllowMultipleValues),
+			"required":              boolValue(filter.Required),
+			"listens_to_filters":    listensToFilters,
+			"ui_config":             uiConfig,
+		})
+	}
+
+	return result, nil
+}
+
+// expandDashboardElement creates the query of a query tile, as queries cannot be updated
+func expandDashboardElement(client *apiclient.LookerSDK, dashboardID string, raw map[string]interface{}) (dashboardElementWrite, error) {
+	elementType := raw["type"].(string)
+	titleHidden := raw["title_hidden"].(bool)
+
+	element := dashboardElementWrite{
+		WriteDashboardElement: apiclient.WriteDashboardElement{
+			DashboardId: &dashboardID,
+			Type:        &elementType,
+			TitleHidden: &titleHidden,
+		},
+	}
+
+	for key, field := range map[string]**string{
+		"title":         &element.Title,
+		"title_text":    &element.TitleText,
+		"subtitle_text": &element.SubtitleText,
+		"body_text":     &element.BodyText,
+		"note_text":     &element.NoteText,
+		"look_id":       &element.LookId,
+	} {
+		if v := raw[key].(string); v != "" {
+			*field = &v
+		}
+	}
+
+	queries := raw["query"].([]interface{})
+	if len(queries) == 0 || queries[0] == nil {
+		return element, nil
+	}
+
+	writeQuery, err := expandQuery(queries[0].(map[string]interface{}))
+	if err != nil {
+		return element, err
+	}
+	query, err := client.CreateQuery(writeQuery, "id", nil)
+	if err != nil {
+		return element, err
+	}
+	element.QueryId = query.Id
+
+	filterables := expandResultMakerFilterables(raw["listen"].(map[string]interface{}), writeQuery.Model, writeQuery.View)
+	element.ResultMaker = &dashboardElementResultMakerWrite{Filterables: &filterables}
+
+	return element, nil
+}
+
+// expandResultMakerFilterables returns an empty list rather than nil when the tile listens
+// to no filter, so that the listeners of a tile that no longer listens to any are removed
+func expandResultMakerFilterables(listen map[string]interface{}, model, view string) []apiclient.ResultMakerFilterables {
+	filterables := []apiclient.ResultMakerFilterables{}
+	if len(listen) == 0 {
+		return filterables
+	}
+
+	filterNames := make([]string, 0, len(listen))
+	for filterName := range listen {
+		filterNames = append(filterNames, filterName)
+	}
+	sort.Strings(filterNames)
+
+	listeners := make([]apiclient.ResultMakerFilterablesListen, 0, len(listen))
+	for _, filterName := range filterNames {
+		filterName := filterName
+		field := listen[filterName].(string)
+		listeners = append(listeners, apiclient.ResultMakerFilterablesListen{
+			DashboardFilterName: &filterName,
+			Field:               &field,
+		})
+	}
+
+	return append(filterables, apiclient.ResultMakerFilterables{
+		Model:  &model,
+		View:   &view,
+		Listen: &listeners,
+	})
+}
+
+func flattenDashboardElements(elements []apiclient.DashboardElement, components map[string]apiclient.DashboardLayoutComponent, appliedVisConfigs map[string]string) ([]map[string]interface{}, error) {
+	result := make([]map[string]interface{}, 0, len(elements))
+	for _, element := range elements {
+		elementID := stringValue(element.Id)
+
+		var queries []map[string]interface{}
+		if element.Query != nil && element.LookId == nil {
+			query, err := flattenQuery(element.Query, appliedVisConfigs[elementID])
+			if err != nil {
+				return nil, fmt.Errorf("dashboard element %s: %v", elementID, err)
+			}
+			queries = append(queries, query)
+		}
+
+		listen := map[string]string{}
+		if element.ResultMaker != nil && element.ResultMaker.Filterables != nil {
+			for _, filterable := range *element.ResultMaker.Filterables {
+				if filterable.Listen == nil {
+					continue
+				}
+				for _, listener := range *filterable.Listen {
+					listen[stringValue(listener.DashboardFilterName)] = stringValue(listener.Field)
+				}
+			}
+		}
+
+		flattened := map[string]interface{}{
+			"id":            elementID,
+			"type":          stringValue(element.Type),
+			"title":         stringValue(element.Title),
+			"title_hidden":  boolValue(element.TitleHidden),
+			"title_text":    stringValue(element.TitleText),
+			"subtitle_text": stringValue(element.SubtitleText),
+			"body_text":     stringValue(element.BodyText),
+			"note_text":     stringValue(element.NoteText),
+			"look_id":       stringValue(element.LookId),
+			"query":         queries,
+			"listen":        listen,
+		}
+
+		if component, ok := components[elementID]; ok {
+			for key, value := range map[string]*int64{
+				"row":    component.Row,
+				"column": component.Column,
+				"width":  component.Width,
+				"height": component.Height,
+			} {
+				if value != nil {
+					flattened[key] = int(*value)
+				}
+			}
+		}
+
+		result = append(result, flattened)
+	}
+
+	return result, nil
+}
+
+func expandQuery(raw map[string]interface{}) (apiclient.WriteQuery, error) {
+	fields := expandStringList(raw["fields"].([]interface{}))
+
+	query := apiclient.WriteQuery{
+		Model:  raw["model"].(string),
+		View:   raw["view"].(string),
+		Fields: &fields,
+	}
+
+	if v := expandStringList(raw["pivots"].([]interface{})); len(v) > 0 {
+		query.Pivots = &v
+	}
+	if v := raw["filters"].(map[string]interface{}); len(v) > 0 {
+		query.Filters = &v
+	}
+	if v := raw["filter_expression"].(string); v != "" {
+		query.FilterExpression = &v
+	}
+	if v := expandStringList(raw["sorts"].([]interface{})); len(v) > 0 {
+		query.Sorts = &v
+	}
+	if v := raw["limit"].(int); v > 0 {
+		limit := strconv.Itoa(v)
+		query.Limit = &limit
+	}
+	if v := raw["total"].(bool); v {
+		query.Total = &v
+	}
+	if v := raw["dynamic_fields"].(string); v != "" {
+		query.DynamicFields = &v
+	}
+	if v := raw["vis_config"].(string); v != "" {
+		var visConfig map[string]interface{}
+		if err := json.Unmarshal([]byte(v), &visConfig); err != nil {
+			return query, fmt.Errorf("invalid vis_config: %v", err)
+		}
+		query.VisConfig = &visConfig
+	}
+	if v := raw["query_timezone"].(string); v != "" {
+		query.QueryTimezone = &v
+	}
+
+	return query, nil
+}
+
+// flattenQuery keeps only the vis_config settings of appliedVisConfig, see marshalAppliedJSONKeys
+func flattenQuery(query *apiclient.Query, appliedVisConfig string) (map[string]interface{}, error) {
+	fields := []string{}
+	if query.Fields != nil {
+		fields = *query.Fields
+	}
+	pivots := []string{}
+	if query.Pivots != nil {
+		pivots = *query.Pivots
+	}
+	sorts := []string{}
+	if query.Sorts != nil {
+		sorts = *query.Sorts
+	}
+
+	filters := map[string]string{}
+	if query.Filters != nil {
+		for field, value := range *query.Filters {
+			filters[field] = fmt.Sprint(value)
+		}
+	}
+
+	var limit int
+	if query.Limit != nil && *query.Limit != "" {
+		v, err := strconv.Atoi(*query.Limit)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected query limit %q", *query.Limit)
+		}
+		limit = v
+	}
+
+	var visConfig string
+	if query.VisConfig != nil {
+		var err error
+		visConfig, err = marshalAppliedJSONKeys(*query.VisConfig, appliedVisConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                stringValue(query.Id),
+		"model":             query.Model,
+		"view":              query.View,
+		"fields":            fields,
+		"pivots":            pivots,
+		"filters":           filters,
+		"filter_expression": stringValue(query.FilterExpression),
+		"sorts":             sorts,
+		"limit":             limit,
+		"total":             boolValue(query.Total),
+		"dynamic_fields":    stringValue(query.DynamicFields),
+		"vis_config":        visConfig,
+		"query_timezone":    stringValue(query.QueryTimezone),
+	}, nil
+}
@@ -0,0 +1,283 @@
+package looker
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_Dashboard(t *testing.T) {
+	title1 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	title2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dashboardConfig(title1, 500),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_dashboard.test", "title", title1),
+					resource.TestCheckResourceAttr("looker_dashboard.test", "filter.#", "1"),
+					resource.TestCheckResourceAttr("looker_dashboard.test", "element.#", "2"),
+					resource.TestCheckResourceAttr("looker_dashboard.test", "element.0.type", "text"),
+					resource.TestCheckResourceAttr("looker_dashboard.test", "element.1.query.0.limit", "500"),
+					resource.TestCheckResourceAttr("looker_dashboard.test", "element.1.listen.Created", "users.created_date"),
+					resource.TestCheckResourceAttr("looker_dashboard.test", "element.1.width", "12"),
+				),
+			},
+			{
+				Config: dashboardConfig(title2, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_dashboard.test", "title", title2),
+					resource.TestCheckResourceAttr("looker_dashboard.test", "element.1.query.0.limit", "100"),
+				),
+			},
+			{
+				ResourceName:      "looker_dashboard.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the import reads every visualization setting, including the defaults Looker adds
+				ImportStateVerifyIgnore: []string{"element.1.query.0.vis_config"},
+			},
+		},
+		CheckDestroy: testAccCheckDashboardDestroy,
+	})
+}
+
+func testAccCheckDashboardDestroy(s *terraform.State) error {
+	client := apiclient.NewLookerSDK(testAccProvider.Meta().(*rtl.AuthSession))
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_dashboard" {
+			continue
+		}
+
+		dashboard, err := client.Dashboard(rs.Primary.ID, "deleted", nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				continue // successfully destroyed
+			}
+			return err
+		}
+		if !boolValue(dashboard.Deleted) {
+			return fmt.Errorf("dashboard still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func dashboardConfig(title string, limit int) string {
+	return fmt.Sprintf(`
+	data "looker_folder" "shared" {
+		path = "Shared"
+	}
+	resource "looker_dashboard" "test" {
+		title     = "%s"
+		folder_id = data.looker_folder.shared.id
+
+		filter {
+			name      = "Created"
+			title     = "Created Date"
+			type      = "field_filter"
+			model     = "thelook"
+			explore   = "users"
+			dimension = "users.created_date"
+		}
+
+		element {
+			type       = "text"
+			title_text = "Users"
+			body_text  = "Signups per state"
+		}
+
+		element {
+			type  = "vis"
+			title = "Signups"
+			query {
+				model      = "thelook"
+				view       = "users"
+				fields     = ["users.state", "users.count"]
+				limit      = %d
+				vis_config = jsonencode({ type = "looker_column" })
+			}
+			listen = {
+				Created = "users.created_date"
+			}
+			row    = 2
+			column = 0
+			width  = 12
+			height = 6
+		}
+	}
+	`, title, limit)
+}
+
+func TestOrderDashboardElements(t *testing.T) {
+	newElements := func(ids ...string) []apiclient.DashboardElement {
+		elements := make([]apiclient.DashboardElement, 0, len(ids))
+		for _, id := range ids {
+			id := id
+			elements = append(elements, apiclient.DashboardElement{Id: &id})
+		}
+		return elements
+	}
+
+	tests := map[string]struct {
+		elements []apiclient.DashboardElement
+		ids      []string
+		wantRes  []apiclient.DashboardElement
+	}{
+		"no known order": {
+			elements: newElements("10", "9", "11"),
+			ids:      nil,
+			wantRes:  newElements("9", "10", "11"),
+		},
+		"configuration order": {
+			elements: newElements("9", "10", "11"),
+			ids:      []string{"11", "9", "10"},
+			wantRes:  newElements("11", "9", "10"),
+		},
+		"unknown elements last": {
+			elements: newElements("12", "9", "10", "11"),
+			ids:      []string{"10", "", "9"},
+			wantRes:  newElements("10", "9", "11", "12"),
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			a.Equal(tt.wantRes, orderDashboardElements(tt.elements, tt.ids))
+		})
+	}
+}
+
+func TestExpandQuery(t *testing.T) {
+	a := assert.New(t)
+
+	raw := map[string]interface{}{
+		"model":             "thelook",
+		"view":              "users",
+		"fields":            []interface{}{"users.state", "users.count"},
+		"pivots":            []interface{}{},
+		"filters":           map[string]interface{}{"users.country": "USA"},
+		"filter_expression": "",
+		"sorts":             []interface{}{"users.count desc"},
+		"limit":             100,
+		"total":             false,
+		"dynamic_fields":    "",
+		"vis_config":        `{"type":"looker_column","stacking":"normal"}`,
+		"query_timezone":    "",
+	}
+
+	query, err := expandQuery(raw)
+	a.NoError(err)
+	a.Equal("thelook", query.Model)
+	a.Equal("users", query.View)
+	a.Equal([]string{"users.state", "users.count"}, *query.Fields)
+	a.Nil(query.Pivots)
+	a.Equal(map[string]interface{}{"users.country": "USA"}, *query.Filters)
+	a.Equal([]string{"users.count desc"}, *query.Sorts)
+	a.Equal("100", *query.Limit)
+	a.Nil(query.Total)
+	a.Equal(map[string]interface{}{"type": "looker_column", "stacking": "normal"}, *query.VisConfig)
+
+	flattened, err := flattenQuery(&apiclient.Query{
+		Model:     query.Model,
+		View:      query.View,
+		Fields:    query.Fields,
+		Filters:   query.Filters,
+		Sorts:     query.Sorts,
+		Limit:     query.Limit,
+		VisConfig: query.VisConfig,
+	}, "")
+	a.NoError(err)
+	a.Equal(100, flattened["limit"])
+	a.Equal(map[string]string{"users.country": "USA"}, flattened["filters"])
+	a.Equal(`{"stacking":"normal","type":"looker_column"}`, flattened["vis_config"])
+}
+
+func TestExpandResultMakerFilterables(t *testing.T) {
+	tests := map[string]struct {
+		listen   map[string]interface{}
+		wantJSON string
+	}{
+		"no listeners": {
+			listen:   map[string]interface{}{},
+			wantJSON: `{"filterables":[]}`,
+		},
+		"sorted listeners": {
+			listen: map[string]interface{}{
+				"state":   "users.state",
+				"country": "users.country",
+			},
+			wantJSON: `{"filterables":[{"model":"thelook","view":"users","listen":[{"dashboard_filter_name":"country","field":"users.country"},{"dashboard_filter_name":"state","field":"users.state"}]}]}`,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			filterables := expandResultMakerFilterables(tt.listen, "thelook", "users")
+			b, err := json.Marshal(dashboardElementResultMakerWrite{Filterables: &filterables})
+			a.NoError(err)
+			a.JSONEq(tt.wantJSON, string(b))
+		})
+	}
+}
+
+func TestValidateDashboardElements(t *testing.T) {
+	newElement := func(lookID string, withQuery bool, listen map[string]interface{}) interface{} {
+		var queries []interface{}
+		if withQuery {
+			queries = append(queries, map[string]interface{}{"model": "thelook", "view": "users"})
+		}
+		return map[string]interface{}{
+			"type":    "vis",
+			"look_id": lookID,
+			"query":   queries,
+			"listen":  listen,
+		}
+	}
+	listen := map[string]interface{}{"State": "users.state"}
+
+	tests := map[string]struct {
+		elements []interface{}
+		wantErr  bool
+	}{
+		"query tile with listeners": {
+			elements: []interface{}{newElement("", true, listen)},
+			wantErr:  false,
+		},
+		"look tile without listeners": {
+			elements: []interface{}{newElement("1", false, map[string]interface{}{})},
+			wantErr:  false,
+		},
+		"look tile with listeners": {
+			elements: []interface{}{newElement("1", false, listen)},
+			wantErr:  true,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			err := validateDashboardElements(tt.elements)
+			if tt.wantErr {
+				a.Error(err)
+			} else {
+				a.NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceFolder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFolderCreate,
+		ReadContext:   resourceFolderRead,
+		UpdateContext: resourceFolderUpdate,
+		DeleteContext: resourceFolderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFolderImport,
+		},
+		Description: "Manages a folder. Changing `parent_id` moves the folder with all its content. Import accepts a folder ID or a path such as `Shared/Finance`.",
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"parent_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the parent folder, e.g. the ID of the Shared folder from the looker_folder data source",
+				Required:    true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_metadata_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFolderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	folder, err := client.CreateFolder(apiclient.CreateFolder{
+		Name:     d.Get("name").(string),
+		ParentId: d.Get("parent_id").(string),
+	}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*folder.Id)
+
+	return resourceFolderRead(ctx, d, m)
+}
+
+func resourceFolderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	folder, err := client.Folder(d.Id(), "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	path, err := buildFolderPath(client, folder)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("name", folder.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parent_id", folder.ParentId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("path", path); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_metadata_id", folder.ContentMetadataId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceFolderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	var body apiclient.UpdateFolder
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		body.Name = &name
+	}
+	if d.HasChange("parent_id") {
+		parentID := d.Get("parent_id").(string)
+		body.ParentId = &parentID
+	}
+
+	_, err := client.UpdateFolder(d.Id(), body, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceFolderRead(ctx, d, m)
+}
+
+func resourceFolderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	_, err := client.DeleteFolder(d.Id(), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil // already deleted outside of terraform
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceFolderImport accepts either a folder ID or a folder path
+func resourceFolderImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	id := d.Id()
+	if !strings.Contains(id, "/") {
+		_, err := client.Folder(id, "id", nil)
+		if err == nil {
+			return []*schema.ResourceData{d}, nil
+		}
+		if !strings.Contains(err.Error(), "404") {
+			return nil, err
+		}
+	}
+
+	folder, err := findFolderByPath(client, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import folder %q: %v", id, err)
+	}
+	d.SetId(*folder.Id)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,90 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestAcc_Folder(t *testing.T) {
+	name1 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	name2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: folderConfig(name1, "looker_folder.department.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_folder.test", "name", name1),
+					resource.TestCheckResourceAttrPair("looker_folder.test", "parent_id", "looker_folder.department", "id"),
+					resource.TestCheckResourceAttr("looker_folder.test", "path", fmt.Sprintf("Shared/%s-department/%s", name1, name1)),
+				),
+			},
+			{
+				Config: folderConfig(name2, "data.looker_folder.shared.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_folder.test", "name", name2),
+					resource.TestCheckResourceAttr("looker_folder.test", "path", fmt.Sprintf("Shared/%s", name2)),
+				),
+			},
+			{
+				ResourceName:      "looker_folder.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "looker_folder.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("Shared/%s", name2),
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccCheckFolderDestroy,
+	})
+}
+
+func testAccCheckFolderDestroy(s *terraform.State) error {
+	client := apiclient.NewLookerSDK(testAccProvider.Meta().(*rtl.AuthSession))
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_folder" {
+			continue
+		}
+
+		_, err := client.Folder(rs.Primary.ID, "", nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				continue // successfully destroyed
+			}
+			return err
+		}
+
+		return fmt.Errorf("folder still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func folderConfig(name, parentID string) string {
+	return fmt.Sprintf(`
+	data "looker_folder" "shared" {
+		path = "Shared"
+	}
+	resource "looker_folder" "department" {
+		name      = "%[1]s-department"
+		parent_id = data.looker_folder.shared.id
+	}
+	resource "looker_folder" "test" {
+		name      = "%[1]s"
+		parent_id = %[2]s
+	}
+	`, name, parentID)
+}
@@ -0,0 +1,182 @@
+package looker
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceLook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLookCreate,
+		ReadContext:   resourceLookRead,
+		UpdateContext: resourceLookUpdate,
+		DeleteContext: resourceLookDelete,
+		Description:   "Manages a look. Queries cannot be changed in Looker, so a change of the query block creates a new query and points the look to it.",
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"folder_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"query": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: querySchema(),
+				},
+			},
+		},
+	}
+}
+
+func resourceLookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	writeLook := expandLook(d)
+	queryID, err := createLookQuery(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	writeLook.QueryId = queryID
+
+	look, err := client.CreateLook(writeLook, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*look.Id)
+
+	return resourceLookRead(ctx, d, m)
+}
+
+func resourceLookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	look, err := client.Look(d.Id(), "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if boolValue(look.Deleted) {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("title", look.Title); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", look.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("folder_id", look.FolderId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("public", boolValue(look.Public)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var queries []map[string]interface{}
+	if look.Query != nil {
+		query, err := flattenQuery(look.Query, d.Get("query.0.vis_config").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		queries = append(queries, query)
+	}
+	if err = d.Set("query", queries); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceLookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	writeLook := expandLook(d)
+	if d.HasChange("query") {
+		queryID, err := createLookQuery(client, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		writeLook.QueryId = queryID
+	}
+
+	_, err := client.UpdateLook(d.Id(), writeLook, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceLookRead(ctx, d, m)
+}
+
+func resourceLookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	_, err := client.DeleteLook(d.Id(), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil // already deleted outside of terraform
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandLook(d *schema.ResourceData) apiclient.WriteLookWithQuery {
+	title := d.Get("title").(string)
+	description := d.Get("description").(string)
+	folderID := d.Get("folder_id").(string)
+	public := d.Get("public").(bool)
+
+	return apiclient.WriteLookWithQuery{
+		Title:       &title,
+		Description: &description,
+		FolderId:    &folderID,
+		Public:      &public,
+	}
+}
+
+// createLookQuery creates the query configured for the look and returns its ID
+func createLookQuery(client *apiclient.LookerSDK, d *schema.ResourceData) (*string, error) {
+	writeQuery, err := expandQuery(d.Get("query.0").(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := client.CreateQuery(writeQuery, "id", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return query.Id, nil
+}
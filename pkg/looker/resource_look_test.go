@@ -0,0 +1,213 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_Look(t *testing.T) {
+	title := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	var queryID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: lookConfig(title, "users.count desc", `{ type = "looker_bar", stacking = "normal" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_look.test", "title", title),
+					resource.TestCheckResourceAttr("looker_look.test", "public", "false"),
+					resource.TestCheckResourceAttr("looker_look.test", "query.0.model", "thelook"),
+					resource.TestCheckResourceAttr("looker_look.test", "query.0.sorts.0", "users.count desc"),
+					resource.TestCheckResourceAttr("looker_look.test", "query.0.filters.users.country", "USA"),
+					resource.TestCheckResourceAttr("looker_look.test", "query.0.vis_config", `{"stacking":"normal","type":"looker_bar"}`),
+					testAccCheckLookQueryID("looker_look.test", &queryID),
+				),
+			},
+			{
+				// removing a visualization setting has to reach the new query
+				Config: lookConfig(title, "users.state", `{ type = "looker_bar" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_look.test", "query.0.sorts.0", "users.state"),
+					resource.TestCheckResourceAttr("looker_look.test", "query.0.vis_config", `{"type":"looker_bar"}`),
+					testAccCheckLookVisConfigKeyRemoved("looker_look.test", "stacking"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["looker_look.test"].Primary.Attributes["query.0.id"] == queryID {
+							return fmt.Errorf("look still points to query %s", queryID)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "looker_look.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the import reads every visualization setting, including the defaults Looker adds
+				ImportStateVerifyIgnore: []string{"query.0.vis_config"},
+			},
+		},
+		CheckDestroy: testAccCheckLookDestroy,
+	})
+}
+
+func testAccCheckLookQueryID(n string, queryID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		*queryID = rs.Primary.Attributes["query.0.id"]
+		if *queryID == "" {
+			return fmt.Errorf("query ID is not set")
+		}
+		return nil
+	}
+}
+
+func testAccCheckLookVisConfigKeyRemoved(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		client := apiclient.NewLookerSDK(testAccProvider.Meta().(*rtl.AuthSession))
+		look, err := client.Look(rs.Primary.ID, "query", nil)
+		if err != nil {
+			return err
+		}
+		if look.Query != nil && look.Query.VisConfig != nil {
+			if _, ok := (*look.Query.VisConfig)[key]; ok {
+				return fmt.Errorf("vis_config of look %s still has %q", rs.Primary.ID, key)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckLookDestroy(s *terraform.State) error {
+	client := apiclient.NewLookerSDK(testAccProvider.Meta().(*rtl.AuthSession))
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_look" {
+			continue
+		}
+
+		look, err := client.Look(rs.Primary.ID, "deleted", nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				continue // successfully destroyed
+			}
+			return err
+		}
+		if !boolValue(look.Deleted) {
+			return fmt.Errorf("look still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func TestLookVisConfigDiff(t *testing.T) {
+	tests := map[string]struct {
+		stateVisConfig  string
+		configVisConfig string
+		wantDiff        bool
+	}{
+		"same settings in another order": {
+			stateVisConfig:  `{"stacking":"normal","type":"looker_bar"}`,
+			configVisConfig: `{"type": "looker_bar", "stacking": "normal"}`,
+			wantDiff:        false,
+		},
+		"removed setting": {
+			stateVisConfig:  `{"stacking":"normal","type":"looker_bar"}`,
+			configVisConfig: `{"type":"looker_bar"}`,
+			wantDiff:        true,
+		},
+		"added setting": {
+			stateVisConfig:  `{"type":"looker_bar"}`,
+			configVisConfig: `{"stacking":"normal","type":"looker_bar"}`,
+			wantDiff:        true,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+
+			state := &terraform.InstanceState{
+				ID: "1",
+				Attributes: map[string]string{
+					"id":                 "1",
+					"title":              "Users",
+					"folder_id":          "1",
+					"public":             "false",
+					"query.#":            "1",
+					"query.0.id":         "10",
+					"query.0.model":      "thelook",
+					"query.0.view":       "users",
+					"query.0.fields.#":   "1",
+					"query.0.fields.0":   "users.count",
+					"query.0.sorts.#":    "0",
+					"query.0.limit":      "500",
+					"query.0.vis_config": tt.stateVisConfig,
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"title":     "Users",
+				"folder_id": "1",
+				"query": []interface{}{
+					map[string]interface{}{
+						"model":      "thelook",
+						"view":       "users",
+						"fields":     []interface{}{"users.count"},
+						"vis_config": tt.configVisConfig,
+					},
+				},
+			})
+
+			diff, err := resourceLook().Diff(context.Background(), state, config, nil)
+			a.NoError(err)
+			changed := false
+			if diff != nil {
+				_, changed = diff.Attributes["query.0.vis_config"]
+			}
+			a.Equal(tt.wantDiff, changed)
+		})
+	}
+}
+
+func lookConfig(title, sort, visConfig string) string {
+	return fmt.Sprintf(`
+	data "looker_folder" "shared" {
+		path = "Shared"
+	}
+	resource "looker_look" "test" {
+		title     = "%s"
+		folder_id = data.looker_folder.shared.id
+
+		query {
+			model  = "thelook"
+			view   = "users"
+			fields = ["users.state", "users.count"]
+			filters = {
+				"users.country" = "USA"
+			}
+			sorts      = ["%s"]
+			limit      = 100
+			vis_config = jsonencode(%s)
+		}
+	}
+	`, title, sort, visConfig)
+}
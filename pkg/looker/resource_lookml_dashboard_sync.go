@@ -0,0 +1,158 @@
+package looker
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceLookMLDashboardSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLookMLDashboardSyncCreate,
+		ReadContext:   resourceLookMLDashboardSyncRead,
+		UpdateContext: resourceLookMLDashboardSyncUpdate,
+		DeleteContext: resourceLookMLDashboardSyncDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Imports a LookML dashboard into a folder as a user-defined dashboard linked to it, and syncs the copy when the LookML dashboard or the project commit changes. " +
+			"Note that a sync updates every dashboard linked to the LookML dashboard.",
+		Schema: map[string]*schema.Schema{
+			"lookml_dashboard_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the LookML dashboard in the form `model::dashboard_name`",
+				Required:    true,
+			},
+			"folder_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"title": {
+				Type:        schema.TypeString,
+				Description: "Title of the imported dashboard. Defaults to the title of the LookML dashboard.",
+				Optional:    true,
+			},
+			"project_commit": {
+				Type:        schema.TypeString,
+				Description: "Commit of the LookML project, e.g. `production_ref` of the looker_project data source. The dashboard is synced whenever it changes.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceLookMLDashboardSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	var body apiclient.WriteDashboard
+	if v, ok := d.GetOk("title"); ok {
+		title := v.(string)
+		body.Title = &title
+	}
+
+	dashboard, err := client.ImportLookmlDashboard(d.Get("lookml_dashboard_id").(string), d.Get("folder_id").(string), body, false, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*dashboard.Id)
+
+	return resourceLookMLDashboardSyncRead(ctx, d, m)
+}
+
+func resourceLookMLDashboardSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	dashboard, err := client.Dashboard(d.Id(), "id,title,folder_id,lookml_link_id,deleted", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if boolValue(dashboard.Deleted) {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("lookml_dashboard_id", stringValue(dashboard.LookmlLinkId)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("folder_id", dashboard.FolderId); err != nil {
+		return diag.FromErr(err)
+	}
+	// the title is only tracked when it overrides the one of the LookML dashboard
+	if d.Get("title").(string) != "" {
+		if err = d.Set("title", dashboard.Title); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceLookMLDashboardSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	lookMLDashboardID := d.Get("lookml_dashboard_id").(string)
+
+	if d.HasChange("lookml_dashboard_id") {
+		// relink the dashboard, the sync below brings its content up to date
+		_, err := client.UpdateDashboard(d.Id(), apiclient.WriteDashboard{LookmlLinkId: &lookMLDashboardID}, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	synced := false
+	if d.HasChanges("lookml_dashboard_id", "project_commit") {
+		if _, err := client.SyncLookmlDashboard(lookMLDashboardID, apiclient.WriteDashboard{}, false, nil); err != nil {
+			return diag.FromErr(err)
+		}
+		synced = true
+	}
+
+	var body apiclient.WriteDashboard
+	update := false
+	if d.HasChange("folder_id") {
+		folderID := d.Get("folder_id").(string)
+		body.FolderId = &folderID
+		update = true
+	}
+	// a sync resets the title to the one of the LookML dashboard
+	if v, ok := d.GetOk("title"); ok && (synced || d.HasChange("title")) {
+		title := v.(string)
+		body.Title = &title
+		update = true
+	}
+	if update {
+		if _, err := client.UpdateDashboard(d.Id(), body, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceLookMLDashboardSyncRead(ctx, d, m)
+}
+
+func resourceLookMLDashboardSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	_, err := client.DeleteDashboard(d.Id(), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil // already deleted outside of terraform
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
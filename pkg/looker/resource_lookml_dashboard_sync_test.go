@@ -0,0 +1,55 @@
+package looker
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_LookMLDashboardSync(t *testing.T) {
+	lookMLDashboardID := os.Getenv("LOOKER_TEST_LOOKML_DASHBOARD_ID")
+	if lookMLDashboardID == "" {
+		t.Skip("LOOKER_TEST_LOOKML_DASHBOARD_ID must be set to a LookML dashboard to import, e.g. `thelook::business_pulse`")
+	}
+	title := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: lookMLDashboardSyncConfig(lookMLDashboardID, title, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_lookml_dashboard_sync.test", "lookml_dashboard_id", lookMLDashboardID),
+					resource.TestCheckResourceAttr("looker_lookml_dashboard_sync.test", "title", title),
+					resource.TestCheckResourceAttrPair("looker_lookml_dashboard_sync.test", "folder_id", "data.looker_folder.shared", "id"),
+				),
+			},
+			{
+				Config: lookMLDashboardSyncConfig(lookMLDashboardID, title, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_lookml_dashboard_sync.test", "title", title),
+					resource.TestCheckResourceAttr("looker_lookml_dashboard_sync.test", "project_commit", "second"),
+				),
+			},
+		},
+	})
+}
+
+func lookMLDashboardSyncConfig(lookMLDashboardID, title, commit string) string {
+	return fmt.Sprintf(`
+	data "looker_folder" "shared" {
+		path = "Shared"
+	}
+	resource "looker_lookml_dashboard_sync" "test" {
+		lookml_dashboard_id = "%s"
+		folder_id           = data.looker_folder.shared.id
+		title               = "%s"
+		project_commit      = "%s"
+	}
+	`, lookMLDashboardID, title, commit)
+}
@@ -0,0 +1,396 @@
+package looker
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const oidcConfigID = "oidc"
+
+func resourceOidcConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOidcConfigCreate,
+		ReadContext:   resourceOidcConfigRead,
+		UpdateContext: resourceOidcConfigUpdate,
+		DeleteContext: resourceOidcConfigDelete,
+		Description: "Manages the OpenID Connect configuration of the instance. There is a single OpenID Connect configuration, destroying the resource only removes it from the state and leaves the configuration unchanged. " +
+			"Looker never returns `client_secret`, so after an import the first plan always shows a change to it, which sends the configured secret again.",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOidcConfigImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether users log in through OpenID Connect. Required so that enabling OpenID Connect, which can lock users out, is always an explicit choice.",
+				Required:    true,
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "Relying party identifier provided by the OpenID provider",
+				Required:    true,
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Description: "Relying party secret provided by the OpenID provider. Looker never returns it, so changes made outside of terraform are not detected.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"audience": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"authorization_endpoint": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"token_endpoint": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"userinfo_endpoint": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scopes": {
+				Type:        schema.TypeList,
+				Description: "Scopes to request. Defaults to the scopes of Looker, e.g. openid, email and profile.",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"user_attribute_map_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"user_attribute_map_first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"user_attribute_map_last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"new_user_migration_types": newUserMigrationTypesSchema(),
+			"alternate_email_login_allowed": {
+				Type:        schema.TypeBool,
+				Description: "Allow admins and users with the login_special_email permission to log in with email and password via /login/email",
+				Optional:    true,
+			},
+			"default_new_user_role_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default_new_user_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"set_roles_from_groups": {
+				Type:        schema.TypeBool,
+				Description: "Set the roles of users from the group mappings",
+				Optional:    true,
+			},
+			"groups_attribute": {
+				Type:        schema.TypeString,
+				Description: "Claim holding the groups of the user",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_mapping":          authGroupMappingSchema(),
+			"user_attribute_mapping": authUserAttributeMappingSchema(),
+			"auth_requires_role": {
+				Type:        schema.TypeBool,
+				Description: "Refuse the login of users without a role from the group mappings",
+				Optional:    true,
+			},
+			"allow_normal_group_membership": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_roles_from_normal_groups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_direct_roles": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOidcConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	if _, err := client.UpdateOidcConfig(expandOidcConfig(d), nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(oidcConfigID)
+
+	return resourceOidcConfigRead(ctx, d, m)
+}
+
+func resourceOidcConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	oidcConfig, err := client.OidcConfig(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// client_secret is write-only and kept as configured
+	if err = d.Set("enabled", boolValue(oidcConfig.Enabled)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("issuer", oidcConfig.Issuer); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("client_id", oidcConfig.Identifier); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("audience", oidcConfig.Audience); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("authorization_endpoint", oidcConfig.AuthorizationEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("token_endpoint", oidcConfig.TokenEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("userinfo_endpoint", oidcConfig.UserinfoEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("scopes", oidcConfig.Scopes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_email", oidcConfig.UserAttributeMapEmail); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_first_name", oidcConfig.UserAttributeMapFirstName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_last_name", oidcConfig.UserAttributeMapLastName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("new_user_migration_types", flattenNewUserMigrationTypes(oidcConfig.NewUserMigrationTypes)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("alternate_email_login_allowed", boolValue(oidcConfig.AlternateEmailLoginAllowed)); err != nil {
+		return diag.FromErr(err)
+	}
+	var defaultNewUserRoles []apiclient.Role
+	if oidcConfig.DefaultNewUserRoles != nil {
+		defaultNewUserRoles = *oidcConfig.DefaultNewUserRoles
+	}
+	if err = d.Set("default_new_user_role_ids", flattenRoleIDs(defaultNewUserRoles)); err != nil {
+		return diag.FromErr(err)
+	}
+	var defaultNewUserGroups []apiclient.Group
+	if oidcConfig.DefaultNewUserGroups != nil {
+		defaultNewUserGroups = *oidcConfig.DefaultNewUserGroups
+	}
+	if err = d.Set("default_new_user_group_ids", flattenGroupIDs(defaultNewUserGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("set_roles_from_groups", boolValue(oidcConfig.SetRolesFromGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_attribute", oidcConfig.GroupsAttribute); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("group_mapping", flattenOidcGroupMappings(oidcConfig.GroupsWithRoleIds)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_mapping", flattenOidcUserAttributeMappings(oidcConfig.UserAttributesWithIds)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("auth_requires_role", boolValue(oidcConfig.AuthRequiresRole)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_normal_group_membership", boolValue(oidcConfig.AllowNormalGroupMembership)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_roles_from_normal_groups", boolValue(oidcConfig.AllowRolesFromNormalGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_direct_roles", boolValue(oidcConfig.AllowDirectRoles)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceOidcConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	if _, err := client.UpdateOidcConfig(expandOidcConfig(d), nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceOidcConfigRead(ctx, d, m)
+}
+
+func resourceOidcConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// disabling OpenID Connect could lock users out, so the configuration is left as is
+	return nil
+}
+
+func resourceOidcConfigImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(oidcConfigID)
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandOidcConfig(d *schema.ResourceData) apiclient.WriteOIDCConfig {
+	enabled := d.Get("enabled").(bool)
+	issuer := d.Get("issuer").(string)
+	identifier := d.Get("client_id").(string)
+	secret := d.Get("client_secret").(string)
+	audience := d.Get("audience").(string)
+	authorizationEndpoint := d.Get("authorization_endpoint").(string)
+	tokenEndpoint := d.Get("token_endpoint").(string)
+	userinfoEndpoint := d.Get("userinfo_endpoint").(string)
+	newUserMigrationTypes := expandNewUserMigrationTypes(d.Get("new_user_migration_types"))
+	alternateEmailLoginAllowed := d.Get("alternate_email_login_allowed").(bool)
+	defaultNewUserRoleIDs := append([]string{}, expandStringListFromSet(d.Get("default_new_user_role_ids"))...)
+	defaultNewUserGroupIDs := append([]string{}, expandStringListFromSet(d.Get("default_new_user_group_ids"))...)
+	setRolesFromGroups := d.Get("set_roles_from_groups").(bool)
+	groupMappings := expandOidcGroupMappings(d.Get("group_mapping").(*schema.Set))
+	userAttributeMappings := expandOidcUserAttributeMappings(d.Get("user_attribute_mapping").(*schema.Set))
+	authRequiresRole := d.Get("auth_requires_role").(bool)
+
+	writeOidcConfig := apiclient.WriteOIDCConfig{
+		Enabled:                    &enabled,
+		Issuer:                     &issuer,
+		Identifier:                 &identifier,
+		Secret:                     &secret,
+		Audience:                   &audience,
+		AuthorizationEndpoint:      &authorizationEndpoint,
+		TokenEndpoint:              &tokenEndpoint,
+		UserinfoEndpoint:           &userinfoEndpoint,
+		NewUserMigrationTypes:      &newUserMigrationTypes,
+		AlternateEmailLoginAllowed: &alternateEmailLoginAllowed,
+		DefaultNewUserRoleIds:      &defaultNewUserRoleIDs,
+		DefaultNewUserGroupIds:     &defaultNewUserGroupIDs,
+		SetRolesFromGroups:         &setRolesFromGroups,
+		GroupsWithRoleIds:          &groupMappings,
+		UserAttributesWithIds:      &userAttributeMappings,
+		AuthRequiresRole:           &authRequiresRole,
+	}
+
+	// optional and computed attributes keep the value of Looker unless configured
+	if v, ok := d.GetOk("scopes"); ok {
+		scopes := expandStringList(v.([]interface{}))
+		writeOidcConfig.Scopes = &scopes
+	}
+	for key, field := range map[string]**string{
+		"user_attribute_map_email":      &writeOidcConfig.UserAttributeMapEmail,
+		"user_attribute_map_first_name": &writeOidcConfig.UserAttributeMapFirstName,
+		"user_attribute_map_last_name":  &writeOidcConfig.UserAttributeMapLastName,
+		"groups_attribute":              &writeOidcConfig.GroupsAttribute,
+	} {
+		if v, ok := d.GetOk(key); ok {
+			s := v.(string)
+			*field = &s
+		}
+	}
+	for key, field := range map[string]**bool{
+		"allow_normal_group_membership":  &writeOidcConfig.AllowNormalGroupMembership,
+		"allow_roles_from_normal_groups": &writeOidcConfig.AllowRolesFromNormalGroups,
+		"allow_direct_roles":             &writeOidcConfig.AllowDirectRoles,
+	} {
+		if v, ok := d.GetOkExists(key); ok { // false has to be distinguished from unset
+			b := v.(bool)
+			*field = &b
+		}
+	}
+
+	return writeOidcConfig
+}
+
+func expandOidcGroupMappings(set *schema.Set) []apiclient.OIDCGroupWrite {
+	mappings := make([]apiclient.OIDCGroupWrite, 0, set.Len())
+	for _, raw := range set.List() {
+		mapping := raw.(map[string]interface{})
+		name := mapping["name"].(string)
+		roleIDs := append([]string{}, expandStringListFromSet(mapping["role_ids"])...)
+		mappings = append(mappings, apiclient.OIDCGroupWrite{
+			Name:    &name,
+			RoleIds: &roleIDs,
+		})
+	}
+	return mappings
+}
+
+func flattenOidcGroupMappings(mappings *[]apiclient.OIDCGroupWrite) []map[string]interface{} {
+	if mappings == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(*mappings))
+	for _, mapping := range *mappings {
+		var roleIDs []string
+		if mapping.RoleIds != nil {
+			roleIDs = *mapping.RoleIds
+		}
+		result = append(result, map[string]interface{}{
+			"name":     stringValue(mapping.Name),
+			"role_ids": flattenStringListToSet(roleIDs),
+		})
+	}
+	return result
+}
+
+func expandOidcUserAttributeMappings(set *schema.Set) []apiclient.OIDCUserAttributeWrite {
+	mappings := make([]apiclient.OIDCUserAttributeWrite, 0, set.Len())
+	for _, raw := range set.List() {
+		mapping := raw.(map[string]interface{})
+		name := mapping["name"].(string)
+		required := mapping["required"].(bool)
+		userAttributeIDs := append([]string{}, expandStringListFromSet(mapping["user_attribute_ids"])...)
+		mappings = append(mappings, apiclient.OIDCUserAttributeWrite{
+			Name:             &name,
+			Required:         &required,
+			UserAttributeIds: &userAttributeIDs,
+		})
+	}
+	return mappings
+}
+
+func flattenOidcUserAttributeMappings(mappings *[]apiclient.OIDCUserAttributeWrite) []map[string]interface{} {
+	if mappings == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(*mappings))
+	for _, mapping := range *mappings {
+		var userAttributeIDs []string
+		if mapping.UserAttributeIds != nil {
+			userAttributeIDs = *mapping.UserAttributeIds
+		}
+		result = append(result, map[string]interface{}{
+			"name":               stringValue(mapping.Name),
+			"required":           boolValue(mapping.Required),
+			"user_attribute_ids": flattenStringListToSet(userAttributeIDs),
+		})
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// the OpenID Connect configuration is a singleton, so the test does not run in parallel and keeps it disabled
+func TestAcc_OidcConfig(t *testing.T) {
+	name := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: oidcConfigConfig(name, "looker", "secret1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_oidc_config.test", "id", oidcConfigID),
+					resource.TestCheckResourceAttr("looker_oidc_config.test", "client_id", "looker"),
+					resource.TestCheckResourceAttr("looker_oidc_config.test", "client_secret", "secret1"),
+					resource.TestCheckResourceAttr("looker_oidc_config.test", "scopes.#", "3"),
+					resource.TestCheckResourceAttr("looker_oidc_config.test", "user_attribute_mapping.#", "1"),
+				),
+			},
+			{
+				Config: oidcConfigConfig(name, "looker-v2", "secret2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_oidc_config.test", "client_id", "looker-v2"),
+					resource.TestCheckResourceAttr("looker_oidc_config.test", "client_secret", "secret2"),
+				),
+			},
+			{
+				ResourceName:            "looker_oidc_config.test",
+				ImportState:             true,
+				ImportStateId:           oidcConfigID,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+		},
+	})
+}
+
+func oidcConfigConfig(name, clientID, clientSecret string) string {
+	return fmt.Sprintf(`
+	resource "looker_user_attribute" "test" {
+		name  = "%[1]s"
+		type  = "string"
+		label = "%[1]s"
+	}
+	resource "looker_oidc_config" "test" {
+		enabled                = false
+		issuer                 = "https://accounts.example.com"
+		client_id              = "%[2]s"
+		client_secret          = "%[3]s"
+		authorization_endpoint = "https://accounts.example.com/authorize"
+		token_endpoint         = "https://accounts.example.com/token"
+		userinfo_endpoint      = "https://accounts.example.com/userinfo"
+		scopes                 = ["openid", "email", "profile"]
+
+		user_attribute_mapping {
+			name               = "department"
+			user_attribute_ids = [looker_user_attribute.test.id]
+		}
+	}
+	`, name, clientID, clientSecret)
+}
+
+func TestOidcUserAttributeMappings(t *testing.T) {
+	a := assert.New(t)
+
+	raw := []interface{}{
+		map[string]interface{}{
+			"name":               "department",
+			"required":           true,
+			"user_attribute_ids": schema.NewSet(schema.HashString, []interface{}{"7"}),
+		},
+	}
+	set := schema.NewSet(schema.HashResource(authUserAttributeMappingSchema().Elem.(*schema.Resource)), raw)
+
+	mappings := expandOidcUserAttributeMappings(set)
+	a.Len(mappings, 1)
+	a.Equal("department", *mappings[0].Name)
+	a.True(*mappings[0].Required)
+	a.Equal([]string{"7"}, *mappings[0].UserAttributeIds)
+
+	flattened := flattenOidcUserAttributeMappings(&mappings)
+	a.Len(flattened, 1)
+	a.Equal("department", flattened[0]["name"])
+	a.Equal(true, flattened[0]["required"])
+	a.True(raw[0].(map[string]interface{})["user_attribute_ids"].(*schema.Set).Equal(flattened[0]["user_attribute_ids"]))
+}
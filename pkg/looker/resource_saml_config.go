@@ -0,0 +1,534 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const samlConfigID = "saml"
+
+func resourceSamlConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSamlConfigCreate,
+		ReadContext:   resourceSamlConfigRead,
+		UpdateContext: resourceSamlConfigUpdate,
+		DeleteContext: resourceSamlConfigDelete,
+		Description: "Manages the SAML configuration of the instance. There is a single SAML configuration, destroying the resource only removes it from the state and leaves the configuration unchanged. " +
+			"`test_before_save` cannot test a login: a SAML login needs a browser session with the identity provider, so the provider can only have Looker validate the settings. " +
+			"Test a login from the SAML page of the Looker admin panel before enabling SAML.",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSamlConfigImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether users log in through SAML. Required so that enabling SAML, which can lock users out, is always an explicit choice.",
+				Required:    true,
+			},
+			"idp_url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"idp_issuer": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"idp_cert": {
+				Type:             schema.TypeString,
+				Description:      "Certificate of the identity provider, with or without PEM header",
+				Required:         true,
+				DiffSuppressFunc: suppressCertificateDiff,
+			},
+			"idp_audience": {
+				Type:        schema.TypeString,
+				Description: "Audience Looker validates in assertions. Not validated when empty.",
+				Optional:    true,
+			},
+			"allowed_clock_drift": {
+				Type:        schema.TypeInt,
+				Description: "Seconds of clock drift allowed when validating assertions",
+				Optional:    true,
+				Computed:    true,
+			},
+			"user_attribute_map_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"user_attribute_map_first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"user_attribute_map_last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"new_user_migration_types": newUserMigrationTypesSchema(),
+			"alternate_email_login_allowed": {
+				Type:        schema.TypeBool,
+				Description: "Allow admins and users with the login_special_email permission to log in with email and password via /login/email",
+				Optional:    true,
+			},
+			"default_new_user_role_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default_new_user_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"set_roles_from_groups": {
+				Type:        schema.TypeBool,
+				Description: "Set the roles of users from the group mappings",
+				Optional:    true,
+			},
+			"groups_finder_type": {
+				Type:         schema.TypeString,
+				Description:  "How groups are found in assertions, one of grouped_attribute_values and individual_attributes",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"grouped_attribute_values", "individual_attributes"}, false),
+			},
+			"groups_attribute": {
+				Type:        schema.TypeString,
+				Description: "Attribute holding the groups, used with grouped_attribute_values",
+				Optional:    true,
+				Computed:    true,
+			},
+			"groups_member_value": {
+				Type:        schema.TypeString,
+				Description: "Value of the group attributes indicating membership, used with individual_attributes",
+				Optional:    true,
+			},
+			"group_mapping":          authGroupMappingSchema(),
+			"user_attribute_mapping": authUserAttributeMappingSchema(),
+			"auth_requires_role": {
+				Type:        schema.TypeBool,
+				Description: "Refuse the login of users without a role from the group mappings",
+				Optional:    true,
+			},
+			"bypass_login_page": {
+				Type:        schema.TypeBool,
+				Description: "Redirect to the identity provider instead of showing the login page",
+				Optional:    true,
+			},
+			"allow_normal_group_membership": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_roles_from_normal_groups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_direct_roles": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"test_before_save": {
+				Type:        schema.TypeBool,
+				Description: "Have Looker validate the settings with a temporary SAML test configuration before saving them. This only checks that Looker accepts the settings, it does not test a login through the identity provider.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func newUserMigrationTypesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Credential types, e.g. email, ldap or google, of existing users that are upgraded on their first login with a matching email instead of creating a new user",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func authGroupMappingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Mappings of identity provider groups to Looker roles",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Name of the group in the identity provider",
+					Required:    true,
+				},
+				"role_ids": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func authUserAttributeMappingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Mappings of identity provider attributes to Looker user attributes",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Name of the attribute in the identity provider",
+					Required:    true,
+				},
+				"required": {
+					Type:        schema.TypeBool,
+					Description: "Refuse the login when the attribute is missing",
+					Optional:    true,
+				},
+				"user_attribute_ids": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceSamlConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := saveSamlConfig(m, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(samlConfigID)
+
+	return append(diags, resourceSamlConfigRead(ctx, d, m)...)
+}
+
+func resourceSamlConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	samlConfig, err := client.SamlConfig(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("enabled", boolValue(samlConfig.Enabled)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("idp_url", samlConfig.IdpUrl); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("idp_issuer", samlConfig.IdpIssuer); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("idp_cert", samlConfig.IdpCert); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("idp_audience", samlConfig.IdpAudience); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allowed_clock_drift", samlConfig.AllowedClockDrift); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_email", samlConfig.UserAttributeMapEmail); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_first_name", samlConfig.UserAttributeMapFirstName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_last_name", samlConfig.UserAttributeMapLastName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("new_user_migration_types", flattenNewUserMigrationTypes(samlConfig.NewUserMigrationTypes)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("alternate_email_login_allowed", boolValue(samlConfig.AlternateEmailLoginAllowed)); err != nil {
+		return diag.FromErr(err)
+	}
+	var defaultNewUserRoles []apiclient.Role
+	if samlConfig.DefaultNewUserRoles != nil {
+		defaultNewUserRoles = *samlConfig.DefaultNewUserRoles
+	}
+	if err = d.Set("default_new_user_role_ids", flattenRoleIDs(defaultNewUserRoles)); err != nil {
+		return diag.FromErr(err)
+	}
+	var defaultNewUserGroups []apiclient.Group
+	if samlConfig.DefaultNewUserGroups != nil {
+		defaultNewUserGroups = *samlConfig.DefaultNewUserGroups
+	}
+	if err = d.Set("default_new_user_group_ids", flattenGroupIDs(defaultNewUserGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("set_roles_from_groups", boolValue(samlConfig.SetRolesFromGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_finder_type", samlConfig.GroupsFinderType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_attribute", samlConfig.GroupsAttribute); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_member_value", samlConfig.GroupsMemberValue); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("group_mapping", flattenSamlGroupMappings(samlConfig.GroupsWithRoleIds)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_mapping", flattenSamlUserAttributeMappings(samlConfig.UserAttributesWithIds)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("auth_requires_role", boolValue(samlConfig.AuthRequiresRole)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("bypass_login_page", boolValue(samlConfig.BypassLoginPage)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_normal_group_membership", boolValue(samlConfig.AllowNormalGroupMembership)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_roles_from_normal_groups", boolValue(samlConfig.AllowRolesFromNormalGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_direct_roles", boolValue(samlConfig.AllowDirectRoles)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSamlConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := saveSamlConfig(m, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceSamlConfigRead(ctx, d, m)...)
+}
+
+func resourceSamlConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// disabling SAML could lock users out, so the configuration is left as is
+	return nil
+}
+
+func resourceSamlConfigImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(samlConfigID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// saveSamlConfig updates the SAML configuration, after validating it with a test configuration when requested
+func saveSamlConfig(m interface{}, d *schema.ResourceData) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	writeSamlConfig := expandSamlConfig(d)
+
+	var diags diag.Diagnostics
+	if d.Get("test_before_save").(bool) {
+		testConfig, err := client.CreateSamlTestConfig(writeSamlConfig, nil)
+		if err != nil {
+			return diag.Errorf("the SAML settings were rejected by Looker, the configuration is not saved: %v", err)
+		}
+		if testConfig.TestSlug != nil {
+			// the settings passed, a leftover test configuration must not prevent saving them
+			if _, err = client.DeleteSamlTestConfig(*testConfig.TestSlug, nil); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "SAML test configuration left behind",
+					Detail:   fmt.Sprintf("Deleting the SAML test configuration %s failed: %v", *testConfig.TestSlug, err),
+				})
+			}
+		}
+	}
+
+	if _, err := client.UpdateSamlConfig(writeSamlConfig, nil); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func expandSamlConfig(d *schema.ResourceData) apiclient.WriteSamlConfig {
+	enabled := d.Get("enabled").(bool)
+	idpURL := d.Get("idp_url").(string)
+	idpIssuer := d.Get("idp_issuer").(string)
+	idpCert := d.Get("idp_cert").(string)
+	idpAudience := d.Get("idp_audience").(string)
+	newUserMigrationTypes := expandNewUserMigrationTypes(d.Get("new_user_migration_types"))
+	alternateEmailLoginAllowed := d.Get("alternate_email_login_allowed").(bool)
+	defaultNewUserRoleIDs := append([]string{}, expandStringListFromSet(d.Get("default_new_user_role_ids"))...)
+	defaultNewUserGroupIDs := append([]string{}, expandStringListFromSet(d.Get("default_new_user_group_ids"))...)
+	setRolesFromGroups := d.Get("set_roles_from_groups").(bool)
+	groupsMemberValue := d.Get("groups_member_value").(string)
+	groupMappings := expandSamlGroupMappings(d.Get("group_mapping").(*schema.Set))
+	userAttributeMappings := expandSamlUserAttributeMappings(d.Get("user_attribute_mapping").(*schema.Set))
+	authRequiresRole := d.Get("auth_requires_role").(bool)
+	bypassLoginPage := d.Get("bypass_login_page").(bool)
+
+	writeSamlConfig := apiclient.WriteSamlConfig{
+		Enabled:                    &enabled,
+		IdpUrl:                     &idpURL,
+		IdpIssuer:                  &idpIssuer,
+		IdpCert:                    &idpCert,
+		IdpAudience:                &idpAudience,
+		NewUserMigrationTypes:      &newUserMigrationTypes,
+		AlternateEmailLoginAllowed: &alternateEmailLoginAllowed,
+		DefaultNewUserRoleIds:      &defaultNewUserRoleIDs,
+		DefaultNewUserGroupIds:     &defaultNewUserGroupIDs,
+		SetRolesFromGroups:         &setRolesFromGroups,
+		GroupsMemberValue:          &groupsMemberValue,
+		GroupsWithRoleIds:          &groupMappings,
+		UserAttributesWithIds:      &userAttributeMappings,
+		AuthRequiresRole:           &authRequiresRole,
+		BypassLoginPage:            &bypassLoginPage,
+	}
+
+	// optional and computed attributes keep the value of Looker unless configured
+	if v, ok := d.GetOk("allowed_clock_drift"); ok {
+		allowedClockDrift := int64(v.(int))
+		writeSamlConfig.AllowedClockDrift = &allowedClockDrift
+	}
+	for key, field := range map[string]**string{
+		"user_attribute_map_email":      &writeSamlConfig.UserAttributeMapEmail,
+		"user_attribute_map_first_name": &writeSamlConfig.UserAttributeMapFirstName,
+		"user_attribute_map_last_name":  &writeSamlConfig.UserAttributeMapLastName,
+		"groups_finder_type":            &writeSamlConfig.GroupsFinderType,
+		"groups_attribute":              &writeSamlConfig.GroupsAttribute,
+	} {
+		if v, ok := d.GetOk(key); ok {
+			s := v.(string)
+			*field = &s
+		}
+	}
+	for key, field := range map[string]**bool{
+		"allow_normal_group_membership":  &writeSamlConfig.AllowNormalGroupMembership,
+		"allow_roles_from_normal_groups": &writeSamlConfig.AllowRolesFromNormalGroups,
+		"allow_direct_roles":             &writeSamlConfig.AllowDirectRoles,
+	} {
+		if v, ok := d.GetOkExists(key); ok { // false has to be distinguished from unset
+			b := v.(bool)
+			*field = &b
+		}
+	}
+
+	return writeSamlConfig
+}
+
+func expandSamlGroupMappings(set *schema.Set) []apiclient.SamlGroupWrite {
+	mappings := make([]apiclient.SamlGroupWrite, 0, set.Len())
+	for _, raw := range set.List() {
+		mapping := raw.(map[string]interface{})
+		name := mapping["name"].(string)
+		roleIDs := append([]string{}, expandStringListFromSet(mapping["role_ids"])...)
+		mappings = append(mappings, apiclient.SamlGroupWrite{
+			Name:    &name,
+			RoleIds: &roleIDs,
+		})
+	}
+	return mappings
+}
+
+func flattenSamlGroupMappings(mappings *[]apiclient.SamlGroupWrite) []map[string]interface{} {
+	if mappings == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(*mappings))
+	for _, mapping := range *mappings {
+		var roleIDs []string
+		if mapping.RoleIds != nil {
+			roleIDs = *mapping.RoleIds
+		}
+		result = append(result, map[string]interface{}{
+			"name":     stringValue(mapping.Name),
+			"role_ids": flattenStringListToSet(roleIDs),
+		})
+	}
+	return result
+}
+
+func expandSamlUserAttributeMappings(set *schema.Set) []apiclient.SamlUserAttributeWrite {
+	mappings := make([]apiclient.SamlUserAttributeWrite, 0, set.Len())
+	for _, raw := range set.List() {
+		mapping := raw.(map[string]interface{})
+		name := mapping["name"].(string)
+		required := mapping["required"].(bool)
+		userAttributeIDs := append([]string{}, expandStringListFromSet(mapping["user_attribute_ids"])...)
+		mappings = append(mappings, apiclient.SamlUserAttributeWrite{
+			Name:             &name,
+			Required:         &required,
+			UserAttributeIds: &userAttributeIDs,
+		})
+	}
+	return mappings
+}
+
+func flattenSamlUserAttributeMappings(mappings *[]apiclient.SamlUserAttributeWrite) []map[string]interface{} {
+	if mappings == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(*mappings))
+	for _, mapping := range *mappings {
+		var userAttributeIDs []string
+		if mapping.UserAttributeIds != nil {
+			userAttributeIDs = *mapping.UserAttributeIds
+		}
+		result = append(result, map[string]interface{}{
+			"name":               stringValue(mapping.Name),
+			"required":           boolValue(mapping.Required),
+			"user_attribute_ids": flattenStringListToSet(userAttributeIDs),
+		})
+	}
+	return result
+}
+
+// expandNewUserMigrationTypes joins the migration types into the comma separated list Looker expects
+func expandNewUserMigrationTypes(set interface{}) string {
+	types := expandStringListFromSet(set)
+	sort.Strings(types)
+	return strings.Join(types, ",")
+}
+
+func flattenNewUserMigrationTypes(migrationTypes *string) []string {
+	var types []string
+	for _, t := range strings.Split(stringValue(migrationTypes), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+func flattenRoleIDs(roles []apiclient.Role) []string {
+	roleIDs := make([]string, 0, len(roles))
+	for _, role := range roles {
+		roleIDs = append(roleIDs, stringValue(role.Id))
+	}
+	return roleIDs
+}
+
+// suppressCertificateDiff ignores PEM headers and whitespace, which Looker strips from certificates
+func suppressCertificateDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeCertificate(old) == normalizeCertificate(new)
+}
+
+func normalizeCertificate(cert string) string {
+	cert = strings.ReplaceAll(cert, "-----BEGIN CERTIFICATE-----", "")
+	cert = strings.ReplaceAll(cert, "-----END CERTIFICATE-----", "")
+	return strings.Join(strings.Fields(cert), "")
+}
@@ -0,0 +1,146 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// the SAML configuration is a singleton, so the test does not run in parallel and keeps SAML disabled
+func TestAcc_SamlConfig(t *testing.T) {
+	name := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: samlConfigConfig(name, "https://idp.example.com/saml", "looker-admins"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_saml_config.test", "id", samlConfigID),
+					resource.TestCheckResourceAttr("looker_saml_config.test", "idp_url", "https://idp.example.com/saml"),
+					resource.TestCheckResourceAttr("looker_saml_config.test", "new_user_migration_types.#", "1"),
+					resource.TestCheckResourceAttr("looker_saml_config.test", "group_mapping.#", "1"),
+					resource.TestCheckResourceAttr("looker_saml_config.test", "default_new_user_role_ids.#", "1"),
+				),
+			},
+			{
+				Config: samlConfigConfig(name, "https://idp.example.com/saml/v2", "looker-developers"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_saml_config.test", "idp_url", "https://idp.example.com/saml/v2"),
+				),
+			},
+			{
+				ResourceName:            "looker_saml_config.test",
+				ImportState:             true,
+				ImportStateId:           samlConfigID,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"test_before_save"},
+			},
+		},
+	})
+}
+
+func samlConfigConfig(name, idpURL, groupName string) string {
+	return fmt.Sprintf(`
+	resource "looker_permission_set" "test" {
+		name        = "%[1]s"
+		permissions = ["access_data", "see_looks"]
+	}
+	resource "looker_model_set" "test" {
+		name   = "%[1]s"
+		models = ["thelook"]
+	}
+	resource "looker_role" "test" {
+		name              = "%[1]s"
+		permission_set_id = looker_permission_set.test.id
+		model_set_id      = looker_model_set.test.id
+	}
+	resource "looker_saml_config" "test" {
+		enabled                       = false
+		idp_url                       = "%[2]s"
+		idp_issuer                    = "https://idp.example.com"
+		idp_cert                      = "MIIBszCCAVmgAwIBAgIUEXAMPLE"
+		alternate_email_login_allowed = true
+		new_user_migration_types      = ["email"]
+		default_new_user_role_ids     = [looker_role.test.id]
+
+		group_mapping {
+			name     = "%[3]s"
+			role_ids = [looker_role.test.id]
+		}
+	}
+	`, name, idpURL, groupName)
+}
+
+func TestSuppressCertificateDiff(t *testing.T) {
+	tests := map[string]struct {
+		old     string
+		new     string
+		wantRes bool
+	}{
+		"equal": {
+			old:     "MIIBszCCAVmgAwIBAgIU",
+			new:     "MIIBszCCAVmgAwIBAgIU",
+			wantRes: true,
+		},
+		"pem header and line breaks": {
+			old:     "MIIBszCCAVmgAwIBAgIU",
+			new:     "-----BEGIN CERTIFICATE-----\nMIIBszCC\nAVmgAwIBAgIU\n-----END CERTIFICATE-----\n",
+			wantRes: true,
+		},
+		"different certificate": {
+			old:     "MIIBszCCAVmgAwIBAgIU",
+			new:     "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIV\n-----END CERTIFICATE-----\n",
+			wantRes: false,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			a.Equal(tt.wantRes, suppressCertificateDiff("idp_cert", tt.old, tt.new, nil))
+		})
+	}
+}
+
+func TestNewUserMigrationTypes(t *testing.T) {
+	a := assert.New(t)
+
+	set := schema.NewSet(schema.HashString, []interface{}{"google", "email"})
+	migrationTypes := expandNewUserMigrationTypes(set)
+	a.Equal("email,google", migrationTypes)
+	a.Equal([]string{"email", "google"}, flattenNewUserMigrationTypes(&migrationTypes))
+
+	empty := ""
+	a.Equal("", expandNewUserMigrationTypes(schema.NewSet(schema.HashString, nil)))
+	a.Nil(flattenNewUserMigrationTypes(&empty))
+	a.Nil(flattenNewUserMigrationTypes(nil))
+}
+
+func TestSamlGroupMappings(t *testing.T) {
+	a := assert.New(t)
+
+	raw := []interface{}{
+		map[string]interface{}{
+			"name":     "looker-admins",
+			"role_ids": schema.NewSet(schema.HashString, []interface{}{"2", "1"}),
+		},
+	}
+	set := schema.NewSet(schema.HashResource(authGroupMappingSchema().Elem.(*schema.Resource)), raw)
+
+	mappings := expandSamlGroupMappings(set)
+	a.Len(mappings, 1)
+	a.Equal("looker-admins", *mappings[0].Name)
+	a.ElementsMatch([]string{"1", "2"}, *mappings[0].RoleIds)
+
+	flattened := flattenSamlGroupMappings(&mappings)
+	a.Len(flattened, 1)
+	a.Equal("looker-admins", flattened[0]["name"])
+	a.True(raw[0].(map[string]interface{})["role_ids"].(*schema.Set).Equal(flattened[0]["role_ids"]))
+}
@@ -0,0 +1,454 @@
+package looker
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceScheduledPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScheduledPlanCreate,
+		ReadContext:   resourceScheduledPlanRead,
+		UpdateContext: resourceScheduledPlanUpdate,
+		DeleteContext: resourceScheduledPlanDelete,
+		Description:   "Manages a scheduled plan delivering a dashboard, look or LookML dashboard to one or more destinations.",
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Description: "Owner of the scheduled plan. Defaults to the API user.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"dashboard_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"dashboard_id", "look_id", "lookml_dashboard_id"},
+			},
+			"look_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"lookml_dashboard_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"crontab": {
+				Type:         schema.TypeString,
+				Description:  "Vixie-style crontab specification of when to run",
+				Optional:     true,
+				ExactlyOneOf: []string{"crontab", "datagroup"},
+			},
+			"datagroup": {
+				Type:        schema.TypeString,
+				Description: "Name of the datagroup whose trigger runs the plan",
+				Optional:    true,
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Description: "Timezone of the crontab. Defaults to the timezone of the instance.",
+				Optional:    true,
+			},
+			"run_as_recipient": {
+				Type:        schema.TypeBool,
+				Description: "Run the plan as each recipient, only applicable to email destinations of Looker users",
+				Optional:    true,
+			},
+			"filters": {
+				Type:        schema.TypeMap,
+				Description: "Filter values to run the dashboard or look with, keyed by filter name",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"require_results": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"require_no_results": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"require_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"send_all_results": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"include_links": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Description: "One of email, webhook, s3 or sftp, or the looker-integration:// URI of an action hub integration",
+							Required:    true,
+							ValidateFunc: validation.Any(
+								validation.StringInSlice([]string{"email", "webhook", "s3", "sftp"}, false),
+								validation.StringMatch(regexp.MustCompile(`^looker-integration://`), "expected an action hub integration URI"),
+							),
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Description: "Email address, webhook URL, s3:// or sftp:// URL of the destination",
+							Optional:    true,
+						},
+						"format": {
+							Type:        schema.TypeString,
+							Description: "Data format to send, e.g. csv, xlsx, inline_json, wysiwyg_pdf or wysiwyg_png",
+							Required:    true,
+						},
+						"apply_formatting": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"apply_vis": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"parameters": {
+							Type:        schema.TypeMap,
+							Description: "Parameters of the destination, e.g. access_key_id and region for S3 or username for SFTP",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"secret_parameters": {
+							Type:        schema.TypeMap,
+							Description: "Secret parameters of the destination, e.g. secret_access_key for S3 or password for SFTP. Looker never returns them, so changes made outside of terraform are not detected.",
+							Optional:    true,
+							Sensitive:   true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceScheduledPlanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	writeScheduledPlan, err := expandScheduledPlan(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	scheduledPlan, err := client.CreateScheduledPlan(writeScheduledPlan, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*scheduledPlan.Id)
+
+	return resourceScheduledPlanRead(ctx, d, m)
+}
+
+func resourceScheduledPlanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	scheduledPlan, err := client.ScheduledPlan(d.Id(), "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("name", scheduledPlan.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_id", scheduledPlan.UserId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("enabled", boolValue(scheduledPlan.Enabled)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("dashboard_id", scheduledPlan.DashboardId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("look_id", scheduledPlan.LookId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("lookml_dashboard_id", scheduledPlan.LookmlDashboardId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("crontab", scheduledPlan.Crontab); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("datagroup", scheduledPlan.Datagroup); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("timezone", scheduledPlan.Timezone); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("run_as_recipient", boolValue(scheduledPlan.RunAsRecipient)); err != nil {
+		return diag.FromErr(err)
+	}
+	filters, err := flattenFiltersString(stringValue(scheduledPlan.FiltersString))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("filters", filters); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("require_results", boolValue(scheduledPlan.RequireResults)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("require_no_results", boolValue(scheduledPlan.RequireNoResults)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("require_change", boolValue(scheduledPlan.RequireChange)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("send_all_results", boolValue(scheduledPlan.SendAllResults)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("include_links", boolValue(scheduledPlan.IncludeLinks)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	destinations, err := flattenScheduledPlanDestinationBlocks(scheduledPlan.ScheduledPlanDestination, d.Get("destination").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("destination", destinations); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceScheduledPlanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	writeScheduledPlan, err := expandScheduledPlan(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateScheduledPlan(d.Id(), writeScheduledPlan, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScheduledPlanRead(ctx, d, m)
+}
+
+func resourceScheduledPlanDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	_, err := client.DeleteScheduledPlan(d.Id(), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil // already deleted outside of terraform
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// expandScheduledPlan builds the scheduled plan including all destinations, as Looker replaces the
+// destinations of a plan on every update
+func expandScheduledPlan(d *schema.ResourceData) (apiclient.WriteScheduledPlan, error) {
+	name := d.Get("name").(string)
+	enabled := d.Get("enabled").(bool)
+	runAsRecipient := d.Get("run_as_recipient").(bool)
+	requireResults := d.Get("require_results").(bool)
+	requireNoResults := d.Get("require_no_results").(bool)
+	requireChange := d.Get("require_change").(bool)
+	sendAllResults := d.Get("send_all_results").(bool)
+	includeLinks := d.Get("include_links").(bool)
+	filtersString := expandFiltersString(d.Get("filters").(map[string]interface{}))
+
+	writeScheduledPlan := apiclient.WriteScheduledPlan{
+		Name:             &name,
+		Enabled:          &enabled,
+		RunAsRecipient:   &runAsRecipient,
+		RequireResults:   &requireResults,
+		RequireNoResults: &requireNoResults,
+		RequireChange:    &requireChange,
+		SendAllResults:   &sendAllResults,
+		IncludeLinks:     &includeLinks,
+		FiltersString:    &filtersString,
+	}
+
+	// unset attributes are only sent to clear a previous value
+	for key, field := range map[string]**string{
+		"user_id":             &writeScheduledPlan.UserId,
+		"dashboard_id":        &writeScheduledPlan.DashboardId,
+		"look_id":             &writeScheduledPlan.LookId,
+		"lookml_dashboard_id": &writeScheduledPlan.LookmlDashboardId,
+		"crontab":             &writeScheduledPlan.Crontab,
+		"datagroup":           &writeScheduledPlan.Datagroup,
+		"timezone":            &writeScheduledPlan.Timezone,
+	} {
+		if v := d.Get(key).(string); v != "" || d.HasChange(key) {
+			*field = &v
+		}
+	}
+
+	destinations, err := expandScheduledPlanDestinations(d.Get("destination").([]interface{}))
+	if err != nil {
+		return writeScheduledPlan, err
+	}
+	writeScheduledPlan.ScheduledPlanDestination = &destinations
+
+	return writeScheduledPlan, nil
+}
+
+func expandScheduledPlanDestinations(raw []interface{}) ([]apiclient.ScheduledPlanDestination, error) {
+	destinations := make([]apiclient.ScheduledPlanDestination, 0, len(raw))
+	for _, r := range raw {
+		destination := r.(map[string]interface{})
+
+		destinationType := destination["type"].(string)
+		format := destination["format"].(string)
+		applyFormatting := destination["apply_formatting"].(bool)
+		applyVis := destination["apply_vis"].(bool)
+
+		write := apiclient.ScheduledPlanDestination{
+			Type:            &destinationType,
+			Format:          &format,
+			ApplyFormatting: &applyFormatting,
+			ApplyVis:        &applyVis,
+		}
+		if v := destination["address"].(string); v != "" {
+			write.Address = &v
+		}
+		if v := destination["message"].(string); v != "" {
+			write.Message = &v
+		}
+
+		parameters, err := encodeDestinationParameters(destination["parameters"].(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		write.Parameters = parameters
+
+		secretParameters, err := encodeDestinationParameters(destination["secret_parameters"].(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		write.SecretParameters = secretParameters
+
+		destinations = append(destinations, write)
+	}
+
+	return destinations, nil
+}
+
+func encodeDestinationParameters(parameters map[string]interface{}) (*string, error) {
+	if len(parameters) == 0 {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, err
+	}
+	encoded := string(b)
+
+	return &encoded, nil
+}
+
+// flattenScheduledPlanDestinationBlocks keeps the write-only secret parameters of the destination at the same position in state
+func flattenScheduledPlanDestinationBlocks(destinations *[]apiclient.ScheduledPlanDestination, current []interface{}) ([]map[string]interface{}, error) {
+	if destinations == nil {
+		return nil, nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(*destinations))
+	for i, destination := range *destinations {
+		parameters, err := decodeDestinationParameters(stringValue(destination.Parameters))
+		if err != nil {
+			return nil, err
+		}
+
+		var secretParameters interface{}
+		if i < len(current) && current[i] != nil {
+			secretParameters = current[i].(map[string]interface{})["secret_parameters"]
+		}
+
+		result = append(result, map[string]interface{}{
+			"type":              stringValue(destination.Type),
+			"address":           stringValue(destination.Address),
+			"format":            stringValue(destination.Format),
+			"apply_formatting":  boolValue(destination.ApplyFormatting),
+			"apply_vis":         boolValue(destination.ApplyVis),
+			"message":           stringValue(destination.Message),
+			"parameters":        parameters,
+			"secret_parameters": secretParameters,
+		})
+	}
+
+	return result, nil
+}
+
+// expandFiltersString encodes filter values as the query string Looker expects, e.g. "?State=CA&Created+Date=7+days"
+func expandFiltersString(filters map[string]interface{}) string {
+	if len(filters) == 0 {
+		return ""
+	}
+
+	values := url.Values{}
+	for name, value := range filters {
+		values.Set(name, value.(string))
+	}
+
+	return "?" + values.Encode()
+}
+
+func flattenFiltersString(filtersString string) (map[string]string, error) {
+	values, err := url.ParseQuery(strings.TrimPrefix(filtersString, "?"))
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]string, len(values))
+	for name := range values {
+		filters[name] = values.Get(name)
+	}
+
+	return filters, nil
+}
@@ -0,0 +1,183 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_ScheduledPlan(t *testing.T) {
+	name1 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	name2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: scheduledPlanConfig(name1, "0 6 * * 1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "name", name1),
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "crontab", "0 6 * * 1"),
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "filters.State", "California"),
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "destination.#", "2"),
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "destination.1.parameters.region", "us-east-1"),
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "destination.1.secret_parameters.secret_access_key", "secret"),
+				),
+			},
+			{
+				Config: scheduledPlanConfig(name2, "0 7 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "name", name2),
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "crontab", "0 7 * * *"),
+				),
+			},
+			{
+				ResourceName:            "looker_scheduled_plan.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"destination.1.secret_parameters"},
+			},
+		},
+		CheckDestroy: testAccCheckScheduledPlanDestroy,
+	})
+}
+
+func testAccCheckScheduledPlanDestroy(s *terraform.State) error {
+	client := apiclient.NewLookerSDK(testAccProvider.Meta().(*rtl.AuthSession))
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_scheduled_plan" {
+			continue
+		}
+
+		_, err := client.ScheduledPlan(rs.Primary.ID, "", nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				continue // successfully destroyed
+			}
+			return err
+		}
+
+		return fmt.Errorf("scheduled plan still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func scheduledPlanConfig(name, crontab string) string {
+	return fmt.Sprintf(`
+	data "looker_folder" "shared" {
+		path = "Shared"
+	}
+	resource "looker_look" "test" {
+		title     = "%[1]s"
+		folder_id = data.looker_folder.shared.id
+
+		query {
+			model  = "thelook"
+			view   = "users"
+			fields = ["users.state", "users.count"]
+		}
+	}
+	resource "looker_scheduled_plan" "test" {
+		name     = "%[1]s"
+		look_id  = looker_look.test.id
+		crontab  = "%[2]s"
+		timezone = "America/Los_Angeles"
+		filters = {
+			State = "California"
+		}
+
+		destination {
+			type    = "email"
+			address = "finance@example.com"
+			format  = "csv"
+		}
+
+		destination {
+			type             = "s3"
+			address          = "s3://finance-reports/weekly/"
+			format           = "xlsx"
+			apply_formatting = true
+			parameters = {
+				access_key_id = "AKIAEXAMPLE"
+				region        = "us-east-1"
+			}
+			secret_parameters = {
+				secret_access_key = "secret"
+			}
+		}
+	}
+	`, name, crontab)
+}
+
+func TestFiltersString(t *testing.T) {
+	tests := map[string]struct {
+		filters       map[string]interface{}
+		filtersString string
+	}{
+		"empty": {
+			filters:       map[string]interface{}{},
+			filtersString: "",
+		},
+		"single filter": {
+			filters:       map[string]interface{}{"State": "California"},
+			filtersString: "?State=California",
+		},
+		"escaped filters": {
+			filters:       map[string]interface{}{"Created Date": "7 days", "Country": "USA,Canada"},
+			filtersString: "?Country=USA%2CCanada&Created+Date=7+days",
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			a.Equal(tt.filtersString, expandFiltersString(tt.filters))
+
+			filters, err := flattenFiltersString(tt.filtersString)
+			a.NoError(err)
+			for name, value := range tt.filters {
+				a.Equal(value, filters[name])
+			}
+			a.Len(filters, len(tt.filters))
+		})
+	}
+}
+
+func TestFlattenScheduledPlanDestinationBlocks(t *testing.T) {
+	a := assert.New(t)
+
+	newDestination := func(destinationType, parameters string) apiclient.ScheduledPlanDestination {
+		format := "csv"
+		return apiclient.ScheduledPlanDestination{
+			Type:       &destinationType,
+			Format:     &format,
+			Parameters: &parameters,
+		}
+	}
+
+	destinations := []apiclient.ScheduledPlanDestination{
+		newDestination("sftp", `{"username":"looker"}`),
+		newDestination("s3", `{"region":"us-east-1"}`),
+	}
+	current := []interface{}{
+		map[string]interface{}{"secret_parameters": map[string]interface{}{"password": "hunter2"}},
+	}
+
+	actual, err := flattenScheduledPlanDestinationBlocks(&destinations, current)
+	a.NoError(err)
+	a.Len(actual, 2)
+	a.Equal(map[string]string{"username": "looker"}, actual[0]["parameters"])
+	a.Equal(map[string]interface{}{"password": "hunter2"}, actual[0]["secret_parameters"])
+	a.Equal(map[string]string{"region": "us-east-1"}, actual[1]["parameters"])
+	a.Nil(actual[1]["secret_parameters"])
+}
@@ -0,0 +1,130 @@
+package looker
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceUserAPICredentials() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserAPICredentialsCreate,
+		ReadContext:   resourceUserAPICredentialsRead,
+		DeleteContext: resourceUserAPICredentialsDelete,
+		Description:   "Manages API3 credentials of a user. Changing `triggers` rotates the credentials; use `create_before_destroy` to create the new credentials before the old ones are deleted.",
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values that rotate the credentials when changed",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Description: "Only known after the credentials are created, it is empty for imported credentials",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_disabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUserAPICredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	userID := d.Get("user_id").(string)
+
+	credentials, err := client.CreateUserCredentialsApi3(userID, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildTwoPartID(&userID, credentials.Id))
+
+	// the secret is only returned on creation
+	if err = d.Set("client_secret", credentials.ClientSecret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceUserAPICredentialsRead(ctx, d, m)
+}
+
+func resourceUserAPICredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	userID, credentialsID, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	credentials, err := client.UserCredentialsApi3(userID, credentialsID, "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("user_id", userID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("client_id", credentials.ClientId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created_at", credentials.CreatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_disabled", boolValue(credentials.IsDisabled)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceUserAPICredentialsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	session := m.(*rtl.AuthSession)
+	client := apiclient.NewLookerSDK(session)
+
+	userID, credentialsID, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.DeleteUserCredentialsApi3(userID, credentialsID, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil // already deleted outside of terraform
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
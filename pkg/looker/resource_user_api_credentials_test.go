@@ -0,0 +1,99 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestAcc_UserAPICredentials(t *testing.T) {
+	name := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	var clientID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: userAPICredentialsConfig(name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("looker_user_api_credentials.test", "user_id", "looker_user.test", "id"),
+					resource.TestCheckResourceAttrSet("looker_user_api_credentials.test", "client_id"),
+					resource.TestCheckResourceAttrSet("looker_user_api_credentials.test", "client_secret"),
+					func(s *terraform.State) error {
+						clientID = s.RootModule().Resources["looker_user_api_credentials.test"].Primary.Attributes["client_id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: userAPICredentialsConfig(name, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("looker_user_api_credentials.test", "client_secret"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["looker_user_api_credentials.test"].Primary.Attributes["client_id"] == clientID {
+							return fmt.Errorf("credentials %s were not rotated", clientID)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "looker_user_api_credentials.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret", "triggers"},
+			},
+		},
+		CheckDestroy: testAccCheckUserAPICredentialsDestroy,
+	})
+}
+
+func testAccCheckUserAPICredentialsDestroy(s *terraform.State) error {
+	client := apiclient.NewLookerSDK(testAccProvider.Meta().(*rtl.AuthSession))
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_user_api_credentials" {
+			continue
+		}
+
+		userID, credentialsID, err := parseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.UserCredentialsApi3(userID, credentialsID, "", nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				continue // successfully destroyed
+			}
+			return err
+		}
+
+		return fmt.Errorf("API3 credentials still exist: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func userAPICredentialsConfig(name, rotation string) string {
+	return fmt.Sprintf(`
+	resource "looker_user" "test" {
+		first_name = "Service"
+		last_name  = "Account"
+		email      = "%s@example.com"
+	}
+	resource "looker_user_api_credentials" "test" {
+		user_id = looker_user.test.id
+		triggers = {
+			rotation = "%s"
+		}
+	}
+	`, name, rotation)
+}
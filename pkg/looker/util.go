@@ -1,7 +1,10 @@
 package looker
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,6 +35,14 @@ func expandStringListFromSet(set interface{}) []string {
 	return strings
 }
 
+func expandStringList(list []interface{}) []string {
+	strings := make([]string, 0, len(list))
+	for _, v := range list {
+		strings = append(strings, v.(string))
+	}
+	return strings
+}
+
 func flattenStringList(strings []string) []interface{} {
 	vs := make([]interface{}, 0, len(strings))
 	for _, v := range strings {
@@ -51,3 +62,78 @@ func expandInt64ListFromSet(set interface{}) []string {
 	}
 	return ints
 }
+
+// return the value pointed by s, or an empty string when s is nil
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// return the value pointed by b, or false when b is nil
+func boolValue(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
+
+// split a folder path `a/b/c` into its folder names, ignoring empty segments
+func splitFolderPath(path string) []string {
+	var names []string
+	for _, name := range strings.Split(path, "/") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// compare two IDs numerically when both are numbers, so that "10" sorts after "9"
+func lessID(a, b string) bool {
+	ai, errA := strconv.ParseInt(a, 10, 64)
+	bi, errB := strconv.ParseInt(b, 10, 64)
+	if errA == nil && errB == nil {
+		return ai < bi
+	}
+	return a < b
+}
+
+// suppress the diff of a JSON attribute when both values encode the same document,
+// so that whitespace and key order are not reported as changes
+func suppressEquivalentJSONDiff(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return old == new
+	}
+
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+// marshalAppliedJSONKeys encodes a JSON object read from Looker with only the keys of the
+// applied one, as Looker fills in defaults for omitted keys. Every key is kept when nothing
+// was applied, e.g. on import.
+func marshalAppliedJSONKeys(object map[string]interface{}, applied string) (string, error) {
+	var appliedObject map[string]interface{}
+	if applied == "" || json.Unmarshal([]byte(applied), &appliedObject) != nil || appliedObject == nil {
+		b, err := json.Marshal(object)
+		return string(b), err
+	}
+
+	retained := make(map[string]interface{}, len(appliedObject))
+	for key := range appliedObject {
+		if value, ok := object[key]; ok {
+			retained[key] = value
+		}
+	}
+	b, err := json.Marshal(retained)
+	return string(b), err
+}
@@ -84,3 +84,168 @@ func TestParseTwoPartID(t *testing.T) {
 		})
 	}
 }
+
+func TestStringValue(t *testing.T) {
+	s := "abc"
+	assert.Equal(t, "abc", stringValue(&s))
+	assert.Equal(t, "", stringValue(nil))
+}
+
+func TestBoolValue(t *testing.T) {
+	b := true
+	assert.True(t, boolValue(&b))
+	assert.False(t, boolValue(nil))
+}
+
+func TestSplitFolderPath(t *testing.T) {
+	tests := map[string]struct {
+		path    string
+		wantRes []string
+	}{
+		"nested path": {
+			path:    "Shared/Finance/Monthly",
+			wantRes: []string{"Shared", "Finance", "Monthly"},
+		},
+		"leading and trailing slashes": {
+			path:    "/Shared/Finance/",
+			wantRes: []string{"Shared", "Finance"},
+		},
+		"spaces around names": {
+			path:    "Shared / Sales Reports",
+			wantRes: []string{"Shared", "Sales Reports"},
+		},
+		"empty path": {
+			path:    "",
+			wantRes: nil,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			assert.Equal(t, tt.wantRes, splitFolderPath(tt.path))
+		})
+	}
+}
+
+func TestLessID(t *testing.T) {
+	tests := map[string]struct {
+		a       string
+		b       string
+		wantRes bool
+	}{
+		"numeric": {
+			a:       "9",
+			b:       "10",
+			wantRes: true,
+		},
+		"numeric reversed": {
+			a:       "10",
+			b:       "9",
+			wantRes: false,
+		},
+		"equal": {
+			a:       "10",
+			b:       "10",
+			wantRes: false,
+		},
+		"non numeric": {
+			a:       "abc",
+			b:       "abd",
+			wantRes: true,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			assert.Equal(t, tt.wantRes, lessID(tt.a, tt.b))
+		})
+	}
+}
+
+func TestSuppressEquivalentJSONDiff(t *testing.T) {
+	tests := map[string]struct {
+		old     string
+		new     string
+		wantRes bool
+	}{
+		"equal": {
+			old:     `{"type":"looker_line"}`,
+			new:     `{"type": "looker_line"}`,
+			wantRes: true,
+		},
+		"key order": {
+			old:     `{"type":"looker_line","show_legend":true}`,
+			new:     `{"show_legend": true, "type": "looker_line"}`,
+			wantRes: true,
+		},
+		"removed key": {
+			old:     `{"type":"looker_line","show_legend":true}`,
+			new:     `{"type":"looker_line"}`,
+			wantRes: false,
+		},
+		"changed value": {
+			old:     `{"type":"looker_line","show_legend":true}`,
+			new:     `{"type":"looker_column","show_legend":true}`,
+			wantRes: false,
+		},
+		"added key": {
+			old:     `{"type":"looker_line"}`,
+			new:     `{"type":"looker_line","stacking":"normal"}`,
+			wantRes: false,
+		},
+		"removed": {
+			old:     `{"type":"looker_line"}`,
+			new:     "",
+			wantRes: false,
+		},
+		"invalid json": {
+			old:     `{"type":"looker_line"}`,
+			new:     `{`,
+			wantRes: false,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			assert.Equal(t, tt.wantRes, suppressEquivalentJSONDiff("vis_config", tt.old, tt.new, nil))
+		})
+	}
+}
+
+func TestMarshalAppliedJSONKeys(t *testing.T) {
+	tests := map[string]struct {
+		object  map[string]interface{}
+		applied string
+		wantRes string
+	}{
+		"nothing applied": {
+			object:  map[string]interface{}{"type": "looker_line", "show_legend": true},
+			applied: "",
+			wantRes: `{"show_legend":true,"type":"looker_line"}`,
+		},
+		"defaults filled in": {
+			object:  map[string]interface{}{"type": "looker_line", "show_legend": true},
+			applied: `{"type":"looker_line"}`,
+			wantRes: `{"type":"looker_line"}`,
+		},
+		"changed outside of terraform": {
+			object:  map[string]interface{}{"type": "looker_column", "show_legend": true},
+			applied: `{"type":"looker_line","show_legend":false}`,
+			wantRes: `{"show_legend":true,"type":"looker_column"}`,
+		},
+		"removed outside of terraform": {
+			object:  map[string]interface{}{"type": "looker_line"},
+			applied: `{"type":"looker_line","show_legend":false}`,
+			wantRes: `{"type":"looker_line"}`,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			a := assert.New(t)
+			res, err := marshalAppliedJSONKeys(tt.object, tt.applied)
+			a.NoError(err)
+			a.Equal(tt.wantRes, res)
+		})
+	}
+}